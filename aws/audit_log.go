@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// AuditLogConfig is the audit log configured in the provider audit_log block.
+type AuditLogConfig struct {
+	Path           string
+	MutatingOnly   bool
+	RedactedFields []string
+}
+
+// auditLogReadOnlyPrefixes are the operation name prefixes of AWS API calls
+// which do not change any resource.
+var auditLogReadOnlyPrefixes = []string{
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Search",
+}
+
+// auditLogEntry is a line of the audit log.
+type auditLogEntry struct {
+	Time       string      `json:"time"`
+	Service    string      `json:"service"`
+	Operation  string      `json:"operation"`
+	Region     string      `json:"region"`
+	DurationMs int64       `json:"duration_ms"`
+	RetryCount int         `json:"retry_count"`
+	StatusCode int         `json:"status_code,omitempty"`
+	ErrorCode  string      `json:"error_code,omitempty"`
+	RequestID  string      `json:"request_id,omitempty"`
+	Parameters interface{} `json:"parameters,omitempty"`
+}
+
+// auditLogFile is an open audit log file, shared by the loggers of every
+// provider configuration writing to the same path.
+type auditLogFile struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// auditLogFiles are the open audit log files by absolute path. The client of
+// every provider configuration, including aliases, is configured with its own
+// logger, so each file is opened once and kept open for the life of the
+// plugin process.
+var (
+	auditLogFiles   = make(map[string]*auditLogFile)
+	auditLogFilesMu sync.Mutex
+)
+
+func openAuditLogFile(path string) (*auditLogFile, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	auditLogFilesMu.Lock()
+	defer auditLogFilesMu.Unlock()
+
+	if f, ok := auditLogFiles[path]; ok {
+		return f, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &auditLogFile{file: file}
+	auditLogFiles[path] = f
+
+	return f, nil
+}
+
+// writeLine appends a line to the file, without interleaving the lines of
+// concurrent writers.
+func (f *auditLogFile) writeLine(line []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, err := f.file.Write(append(line, '\n'))
+	return err
+}
+
+// auditLogger writes a JSON document per completed AWS API call to the
+// audit log file.
+type auditLogger struct {
+	config   *AuditLogConfig
+	redactor fieldRedactor
+	file     *auditLogFile
+}
+
+func newAuditLogger(config *AuditLogConfig) (*auditLogger, error) {
+	f, err := openAuditLogFile(config.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening audit log (%s): %s", config.Path, err)
+	}
+
+	return &auditLogger{
+		config:   config,
+		redactor: newFieldRedactor(defaultRedactedFields, config.RedactedFields),
+		file:     f,
+	}, nil
+}
+
+// handler returns a named request handler logging the completed request.
+func (l *auditLogger) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform.AuditLogHandler",
+		Fn:   l.log,
+	}
+}
+
+func (l *auditLogger) log(r *request.Request) {
+	if l.config.MutatingOnly && isReadOnlyOperation(r.Operation.Name) {
+		return
+	}
+
+	line, err := json.Marshal(l.entry(r))
+	if err != nil {
+		log.Printf("[WARN] Error encoding audit log entry for %s.%s: %s", r.ClientInfo.ServiceName, r.Operation.Name, err)
+		return
+	}
+
+	if err := l.file.writeLine(line); err != nil {
+		log.Printf("[WARN] Error writing audit log (%s): %s", l.config.Path, err)
+	}
+}
+
+func (l *auditLogger) entry(r *request.Request) *auditLogEntry {
+	entry := &auditLogEntry{
+		Time:       r.Time.UTC().Format(time.RFC3339Nano),
+		Service:    r.ClientInfo.ServiceName,
+		Operation:  r.Operation.Name,
+		Region:     aws.StringValue(r.Config.Region),
+		DurationMs: int64(time.Since(r.Time) / time.Millisecond),
+		RetryCount: r.RetryCount,
+		RequestID:  r.RequestID,
+		Parameters: l.parameters(r),
+	}
+	if r.HTTPResponse != nil {
+		entry.StatusCode = r.HTTPResponse.StatusCode
+	}
+	if awsErr, ok := r.Error.(awserr.Error); ok {
+		entry.ErrorCode = awsErr.Code()
+	}
+
+	return entry
+}
+
+// parameters returns the request parameters with the redacted fields and
+// the sensitive fields of the operation masked.
+func (l *auditLogger) parameters(r *request.Request) interface{} {
+	b, err := json.Marshal(r.Params)
+	if err != nil {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil
+	}
+
+	return l.redactor.withOperation(r.ClientInfo.ServiceName, r.Operation.Name).redact(v)
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range auditLogReadOnlyPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
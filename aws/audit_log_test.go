@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestAuditLogger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraform_aws_audit_log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.jsonl")
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=GetCallerIdentity&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsResponse_GetCallerIdentity_valid, "text/xml"},
+		},
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900" +
+				"&RoleArn=arn%3Aaws%3Aiam%3A%3A555555555555%3Arole%2Ftagged&RoleSessionName=terraform&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsResponse_AssumeRole_valid, "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auditLogger, err := newAuditLogger(&AuditLogConfig{
+		Path:           path,
+		MutatingOnly:   true,
+		RedactedFields: []string{"RoleSessionName"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stsSess.Handlers.Complete.PushBackNamed(auditLogger.handler())
+	stsConn := sts.New(stsSess)
+
+	if _, err := stsConn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stsConn.AssumeRole(&sts.AssumeRoleInput{
+		DurationSeconds: aws.Int64(900),
+		RoleArn:         aws.String("arn:aws:iam::555555555555:role/tagged"),
+		RoleSessionName: aws.String("terraform"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = stsConn.AssumeRole(&sts.AssumeRoleInput{
+		RoleArn:         aws.String("arn:aws:iam::555555555555:role/unknown"),
+		RoleSessionName: aws.String("terraform"),
+	})
+	if err == nil {
+		t.Fatal("Expected an error for an unknown role, got none")
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 audit log entries for the mutating calls, got %d: %s", len(lines), b)
+	}
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry["service"] != "sts" || entry["operation"] != "AssumeRole" || entry["region"] != "us-east-1" {
+		t.Fatalf("Unexpected audit log entry: %s", lines[0])
+	}
+	if entry["status_code"] != float64(200) {
+		t.Fatalf("Expected status code 200, got: %s", lines[0])
+	}
+	parameters := entry["parameters"].(map[string]interface{})
+	if parameters["RoleArn"] != "arn:aws:iam::555555555555:role/tagged" {
+		t.Fatalf("Expected the RoleArn parameter, got: %s", lines[0])
+	}
+	if parameters["RoleSessionName"] != redactedValue {
+		t.Fatalf("Expected the RoleSessionName parameter to be redacted, got: %s", lines[0])
+	}
+
+	if err := json.Unmarshal([]byte(lines[1]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry["error_code"] == nil || entry["error_code"] == "" {
+		t.Fatalf("Expected an error code, got: %s", lines[1])
+	}
+}
+
+func TestNewAuditLoggerSharedFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraform_aws_audit_log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.jsonl")
+
+	first, err := newAuditLogger(&AuditLogConfig{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := newAuditLogger(&AuditLogConfig{Path: filepath.Join(dir, ".", "audit.jsonl"), MutatingOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first.file != second.file {
+		t.Fatal("Expected the audit loggers of the same path to share the file")
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	cases := map[string]bool{
+		"DescribeInstances": true,
+		"GetCallerIdentity": true,
+		"ListRoles":         true,
+		"RunInstances":      false,
+		"PutBucketPolicy":   false,
+		"DeleteTags":        false,
+	}
+
+	for name, expected := range cases {
+		if got := isReadOnlyOperation(name); got != expected {
+			t.Fatalf("%s: expected %t, got %t", name, expected, got)
+		}
+	}
+}
@@ -3,8 +3,12 @@ package aws
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/url"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -222,42 +227,117 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		}
 	}
 
+	if c.AssumeRoleARN == "" && len(c.AssumeRoleChainARNs) > 0 {
+		return nil, errors.New("assume_role chained_role_arns requires role_arn to be set")
+	}
+
 	// This is the "normal" flow (i.e. not assuming a role)
-	if c.AssumeRoleARN == "" {
+	if c.AssumeRoleARN == "" && c.AssumeRoleWithWebIdentityARN == "" {
 		return awsCredentials.NewChainCredentials(providers), nil
 	}
 
-	// Otherwise we need to construct and STS client with the main credentials, and verify
-	// that we can assume the defined role.
-	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q)",
-		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy)
+	var creds *awsCredentials.Credentials
+	if c.AssumeRoleWithWebIdentityARN != "" {
+		// The web identity token replaces the credentials from the chain, as
+		// sts:AssumeRoleWithWebIdentity is an unsigned call.
+		log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q, WebIdentityTokenFile: %q)",
+			c.AssumeRoleWithWebIdentityARN, c.AssumeRoleWithWebIdentitySessionName, c.AssumeRoleWithWebIdentityTokenFile)
 
-	creds := awsCredentials.NewChainCredentials(providers)
-	cp, err := creds.Get()
-	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
-			return nil, errors.New(`No valid credential sources found for AWS Provider.
+		httpClient, err := c.httpClient()
+		if err != nil {
+			return nil, err
+		}
+
+		stsclient := sts.New(session.New(&aws.Config{
+			Credentials: awsCredentials.AnonymousCredentials,
+			Region:      aws.String(c.Region),
+			MaxRetries:  aws.Int(c.MaxRetries),
+			HTTPClient:  httpClient,
+		}))
+		webIdentityProvider := &webIdentityRoleProvider{
+			Client:          stsclient,
+			RoleARN:         c.AssumeRoleWithWebIdentityARN,
+			RoleSessionName: c.AssumeRoleWithWebIdentitySessionName,
+			TokenFilePath:   c.AssumeRoleWithWebIdentityTokenFile,
+			Duration:        time.Duration(c.AssumeRoleWithWebIdentityDurationSeconds) * time.Second,
+		}
+
+		creds = awsCredentials.NewCredentials(webIdentityProvider)
+		if _, err := creds.Get(); err != nil {
+			return nil, fmt.Errorf("The role %q cannot be assumed with web identity: %s", c.AssumeRoleWithWebIdentityARN, err)
+		}
+
+		if c.AssumeRoleARN == "" {
+			return creds, nil
+		}
+	} else {
+		// Otherwise we need to construct and STS client with the main credentials, and verify
+		// that we can assume the defined role.
+		creds = awsCredentials.NewChainCredentials(providers)
+		cp, err := creds.Get()
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
+				return nil, errors.New(`No valid credential sources found for AWS Provider.
   Please see https://terraform.io/docs/providers/aws/index.html for more information on
   providing credentials for the AWS Provider`)
+			}
+
+			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
 		}
 
-		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+		log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
 	}
 
-	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+	// Each role in the chain is assumed with the credentials of the previous one.
+	// Session tags are only passed when assuming the first role, later roles
+	// inherit the transitive ones. Chained role sessions are limited to one hour,
+	// so the configured duration only applies to the role used by the provider
+	// and intermediate roles keep the default duration.
+	roleARNs := append([]string{c.AssumeRoleARN}, c.AssumeRoleChainARNs...)
+	for i, roleARN := range roleARNs {
+		durationSeconds := 0
+		if i == len(roleARNs)-1 {
+			durationSeconds = c.AssumeRoleDurationSeconds
+		}
+
+		var err error
+		creds, err = getAssumeRoleCredentials(c, creds, roleARN, i == 0, durationSeconds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return creds, nil
+}
+
+// getAssumeRoleCredentials assumes the given role using creds for the given
+// duration, or the AWS SDK default when zero, and verifies the resulting
+// credentials can be retrieved.
+func getAssumeRoleCredentials(c *Config, creds *awsCredentials.Credentials, roleARN string, sessionTags bool, durationSeconds int) (*awsCredentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q, DurationSeconds: %d)",
+		roleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy, durationSeconds)
+
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return nil, err
+	}
 
 	awsConfig := &aws.Config{
 		Credentials:      creds,
 		Region:           aws.String(c.Region),
 		MaxRetries:       aws.Int(c.MaxRetries),
-		HTTPClient:       cleanhttp.DefaultClient(),
+		HTTPClient:       httpClient,
 		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 	}
 
 	stsclient := sts.New(session.New(awsConfig))
+	if sessionTags && (len(c.AssumeRoleTags) > 0 || len(c.AssumeRoleTransitiveTagKeys) > 0) {
+		stsclient.Handlers.Build.PushBack(stsSessionTagsBuildHandler(c.AssumeRoleTags, c.AssumeRoleTransitiveTagKeys))
+	}
+
 	assumeRoleProvider := &stscreds.AssumeRoleProvider{
 		Client:  stsclient,
-		RoleARN: c.AssumeRoleARN,
+		RoleARN: roleARN,
 	}
 	if c.AssumeRoleSessionName != "" {
 		assumeRoleProvider.RoleSessionName = c.AssumeRoleSessionName
@@ -268,8 +348,11 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	if c.AssumeRolePolicy != "" {
 		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
 	}
+	if durationSeconds > 0 {
+		assumeRoleProvider.Duration = time.Duration(durationSeconds) * time.Second
+	}
 
-	providers = []awsCredentials.Provider{assumeRoleProvider}
+	providers := []awsCredentials.Provider{assumeRoleProvider}
 
 	assumeRoleCreds := awsCredentials.NewChainCredentials(providers)
 	_, err = assumeRoleCreds.Get()
@@ -280,7 +363,7 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 				"    * The credentials used in order to assume the role are invalid\n"+
 				"    * The credentials do not have appropriate permission to assume the role\n"+
 				"    * The role ARN is not valid",
-				c.AssumeRoleARN)
+				roleARN)
 		}
 
 		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
@@ -289,6 +372,95 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	return assumeRoleCreds, nil
 }
 
+// stsSessionTagsBuildHandler returns a request handler adding session tags and
+// transitive tag keys to sts:AssumeRole requests. The AssumeRoleInput of the
+// vendored SDK has no fields for them, so they are appended to the built query.
+func stsSessionTagsBuildHandler(tags map[string]string, transitiveTagKeys []string) func(*request.Request) {
+	return func(r *request.Request) {
+		if r.Error != nil || r.Operation.Name != "AssumeRole" {
+			return
+		}
+
+		b, err := ioutil.ReadAll(r.GetBody())
+		if err != nil {
+			r.Error = awserr.New("SerializationError", "failed reading AssumeRole request body", err)
+			return
+		}
+		body, err := url.ParseQuery(string(b))
+		if err != nil {
+			r.Error = awserr.New("SerializationError", "failed parsing AssumeRole request body", err)
+			return
+		}
+
+		keys := make([]string, 0, len(tags))
+		for k := range tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for i, k := range keys {
+			body.Set(fmt.Sprintf("Tags.member.%d.Key", i+1), k)
+			body.Set(fmt.Sprintf("Tags.member.%d.Value", i+1), tags[k])
+		}
+		for i, k := range transitiveTagKeys {
+			body.Set(fmt.Sprintf("TransitiveTagKeys.member.%d", i+1), k)
+		}
+
+		r.SetBufferBody([]byte(body.Encode()))
+	}
+}
+
+const webIdentityRoleProviderName = "WebIdentityRoleProvider"
+
+// webIdentityRoleProvider retrieves credentials with sts:AssumeRoleWithWebIdentity,
+// reading the web identity token from a file on every retrieval so that
+// rotated tokens are picked up.
+type webIdentityRoleProvider struct {
+	awsCredentials.Expiry
+
+	Client          *sts.STS
+	RoleARN         string
+	RoleSessionName string
+	TokenFilePath   string
+	Duration        time.Duration
+}
+
+// Retrieve generates a new set of temporary credentials using STS.
+func (p *webIdentityRoleProvider) Retrieve() (awsCredentials.Value, error) {
+	token, err := ioutil.ReadFile(p.TokenFilePath)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: webIdentityRoleProviderName},
+			fmt.Errorf("error reading web identity token file (%s): %s", p.TokenFilePath, err)
+	}
+
+	sessionName := p.RoleSessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.RoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(token))),
+	}
+	if p.Duration > 0 {
+		input.DurationSeconds = aws.Int64(int64(p.Duration / time.Second))
+	}
+
+	output, err := p.Client.AssumeRoleWithWebIdentity(input)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: webIdentityRoleProviderName}, err
+	}
+
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), 0)
+
+	return awsCredentials.Value{
+		AccessKeyID:     aws.StringValue(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.Credentials.SessionToken),
+		ProviderName:    webIdentityRoleProviderName,
+	}, nil
+}
+
 func setOptionalEndpoint(cfg *aws.Config) string {
 	endpoint := os.Getenv("AWS_METADATA_URL")
 	if endpoint != "" {
@@ -8,9 +8,14 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
@@ -749,6 +754,117 @@ func TestAWSGetCredentials_shouldBeENV(t *testing.T) {
 
 // unsetEnv unsets environment variables for testing a "clean slate" with no
 // credentials in the environment
+func TestAWSWebIdentityRoleProvider(t *testing.T) {
+	file, err := ioutil.TempFile(os.TempDir(), "terraform_aws_web_identity_token")
+	if err != nil {
+		t.Fatalf("Error writing temporary web identity token file: %s", err)
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.WriteString("mock-token\n"); err != nil {
+		t.Fatalf("Error writing temporary web identity token file: %s", err)
+	}
+	file.Close()
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRoleWithWebIdentity&DurationSeconds=3600" +
+				"&RoleArn=arn%3Aaws%3Aiam%3A%3A555555555555%3Arole%2Fweb-identity&RoleSessionName=terraform" +
+				"&Version=2011-06-15&WebIdentityToken=mock-token"},
+			Response: &awsMockResponse{200, stsResponse_AssumeRoleWithWebIdentity_valid, "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds := awsCredentials.NewCredentials(&webIdentityRoleProvider{
+		Client:          sts.New(stsSess),
+		RoleARN:         "arn:aws:iam::555555555555:role/web-identity",
+		RoleSessionName: "terraform",
+		TokenFilePath:   file.Name(),
+		Duration:        time.Hour,
+	})
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error getting web identity credentials: %s", err)
+	}
+	if v.AccessKeyID != "webIdentityAccessKey" {
+		t.Fatalf("AccessKeyID mismatch, expected: (webIdentityAccessKey), got (%s)", v.AccessKeyID)
+	}
+	if v.SecretAccessKey != "webIdentitySecretKey" {
+		t.Fatalf("SecretAccessKey mismatch, expected: (webIdentitySecretKey), got (%s)", v.SecretAccessKey)
+	}
+	if v.SessionToken != "webIdentitySessionToken" {
+		t.Fatalf("SessionToken mismatch, expected: (webIdentitySessionToken), got (%s)", v.SessionToken)
+	}
+	if v.ProviderName != webIdentityRoleProviderName {
+		t.Fatalf("ProviderName mismatch, expected: (%s), got (%s)", webIdentityRoleProviderName, v.ProviderName)
+	}
+}
+
+func TestAWSWebIdentityRoleProvider_missingTokenFile(t *testing.T) {
+	creds := awsCredentials.NewCredentials(&webIdentityRoleProvider{
+		Client:        sts.New(session.New(&aws.Config{Region: aws.String("us-east-1")})),
+		RoleARN:       "arn:aws:iam::555555555555:role/web-identity",
+		TokenFilePath: "/nonexistent/terraform_aws_web_identity_token",
+	})
+
+	if _, err := creds.Get(); err == nil {
+		t.Fatal("Expected an error reading the web identity token file, got none")
+	}
+}
+
+func TestAWSStsSessionTagsBuildHandler(t *testing.T) {
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900" +
+				"&RoleArn=arn%3Aaws%3Aiam%3A%3A555555555555%3Arole%2Ftagged&RoleSessionName=terraform" +
+				"&Tags.member.1.Key=CostCenter&Tags.member.1.Value=12345" +
+				"&Tags.member.2.Key=Project&Tags.member.2.Value=ci" +
+				"&TransitiveTagKeys.member.1=Project&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsResponse_AssumeRole_valid, "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stsConn := sts.New(stsSess)
+	stsConn.Handlers.Build.PushBack(stsSessionTagsBuildHandler(map[string]string{
+		"Project":    "ci",
+		"CostCenter": "12345",
+	}, []string{"Project"}))
+
+	creds := awsCredentials.NewCredentials(&stscreds.AssumeRoleProvider{
+		Client:          stsConn,
+		RoleARN:         "arn:aws:iam::555555555555:role/tagged",
+		RoleSessionName: "terraform",
+	})
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error getting assumed role credentials: %s", err)
+	}
+	if v.AccessKeyID != "assumedRoleAccessKey" {
+		t.Fatalf("AccessKeyID mismatch, expected: (assumedRoleAccessKey), got (%s)", v.AccessKeyID)
+	}
+}
+
+func TestAWSGetCredentials_chainedRoleARNsWithoutRoleARN(t *testing.T) {
+	_, err := GetCredentials(&Config{
+		AccessKey:            "accessKey",
+		SecretKey:            "secretKey",
+		AssumeRoleChainARNs:  []string{"arn:aws:iam::555555555555:role/chained"},
+		SkipMetadataApiCheck: true,
+	})
+	if err == nil {
+		t.Fatal("Expected an error with chained_role_arns and no role_arn, got none")
+	}
+}
+
 func unsetEnv(t *testing.T) func() {
 	// Grab any existing AWS keys and preserve. In some tests we'll unset these, so
 	// we need to have them and restore them after
@@ -999,3 +1115,40 @@ const iamResponse_ListRoles_unauthorized = `<ErrorResponse xmlns="https://iam.am
   </Error>
   <RequestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestId>
 </ErrorResponse>`
+
+const stsResponse_AssumeRole_valid = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::555555555555:assumed-role/tagged/terraform</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:terraform</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>assumedRoleAccessKey</AccessKeyId>
+      <SecretAccessKey>assumedRoleSecretKey</SecretAccessKey>
+      <SessionToken>assumedRoleSessionToken</SessionToken>
+      <Expiration>2099-12-31T23:59:59Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
+
+const stsResponse_AssumeRoleWithWebIdentity_valid = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <SubjectFromWebIdentityToken>system:serviceaccount:ci:runner</SubjectFromWebIdentityToken>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::555555555555:assumed-role/web-identity/terraform</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:terraform</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>webIdentityAccessKey</AccessKeyId>
+      <SecretAccessKey>webIdentitySecretKey</SecretAccessKey>
+      <SessionToken>webIdentitySessionToken</SessionToken>
+      <Expiration>2099-12-31T23:59:59Z</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"log"
-	"regexp"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -241,7 +240,7 @@ func autoscalingTagFromMap(attr map[string]interface{}, resourceID string) (*aut
 		ResourceType:      aws.String("auto-scaling-group"),
 	}
 
-	if tagIgnoredAutoscaling(t) {
+	if tagIgnoredAutoscaling(t, nil) {
 		return nil, nil
 	}
 
@@ -274,14 +273,6 @@ func setToMapByKey(s *schema.Set) map[string]interface{} {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredAutoscaling(t *autoscaling.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredAutoscaling(t *autoscaling.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
@@ -1,37 +1,461 @@
 package aws
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
+// flagSweepDryRun logs the AWS API calls of the sweepers which would change
+// resources, such as deletions, instead of sending them.
+var flagSweepDryRun = flag.Bool("sweep-dry-run", false, "Log the resources the Sweepers would destroy without destroying them")
+
+// flagSweepPrefixes overrides the name prefixes of the resources swept by the
+// sweepers filtering resources with testSweepSkipResourceName.
+var flagSweepPrefixes = flag.String("sweep-prefixes", "", "Comma separated list of name prefixes of the resources to sweep")
+
+// sweeperEndpointsEnvVar is a comma separated list of service=URL endpoints
+// the sweepers send requests to instead of AWS, for example a local mock
+// server. A URL without service is the endpoint of every service.
+const sweeperEndpointsEnvVar = "AWS_SWEEPER_ENDPOINTS"
+
+// defaultSweepResourceNamePrefix is the name prefix of the resources created
+// by the acceptance tests.
+const defaultSweepResourceNamePrefix = "tf-acc-test-"
+
+// testSweepers is the registry of sweepers, by name.
+var testSweepers = make(map[string]*resource.Sweeper)
+
 func TestMain(m *testing.M) {
+	flag.Parse()
+
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		if err := runTestSweepers(strings.Split(regions, ","), flag.Lookup("sweep-run").Value.String()); err != nil {
+			log.Fatalf("[ERR] %s", err)
+		}
+		return
+	}
+
 	resource.TestMain(m)
 }
 
+// addTestSweepers registers a sweeper, run with the -sweep flag after the
+// sweepers it depends on.
+func addTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := testSweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	testSweepers[name] = s
+	resource.AddTestSweepers(name, s)
+}
+
+// runTestSweepers runs the sweepers matching the filter and their
+// dependencies in every region, each sweeper after its dependencies.
+func runTestSweepers(regions []string, filter string) error {
+	names, err := testSweeperOrder(filter)
+	if err != nil {
+		return err
+	}
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		if *flagSweepDryRun {
+			log.Printf("[INFO] Dry run of Sweepers for region (%s), no resources are destroyed", region)
+		} else {
+			log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+		}
+
+		for _, name := range names {
+			log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+			if err := testSweepers[name].F(region); err != nil {
+				return fmt.Errorf("error running (%s): %s", name, err)
+			}
+		}
+
+		log.Printf("Sweeper Tests ran:\n")
+		for _, name := range names {
+			fmt.Printf("\t- %s\n", name)
+		}
+	}
+
+	return nil
+}
+
+// testSweeperOrder returns the names of the sweepers matching the comma
+// separated filter, or of all sweepers with an empty filter, and of their
+// dependencies. Each sweeper comes after its dependencies.
+func testSweeperOrder(filter string) ([]string, error) {
+	var names []string
+	for name := range testSweepers {
+		if testSweeperMatches(name, filter) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int)
+	var order []string
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("sweeper dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		}
+
+		s, ok := testSweepers[name]
+		if !ok {
+			return fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", path[len(path)-1], name)
+		}
+
+		state[name] = visiting
+		for _, dep := range s.Dependencies {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		order = append(order, name)
+
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+func testSweeperMatches(name, filter string) bool {
+	if filter == "" {
+		return true
+	}
+
+	for _, f := range strings.Split(filter, ",") {
+		if f = strings.TrimSpace(f); f != "" && strings.Contains(name, f) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// testSweepResourceNamePrefixes returns the prefixes of the -sweep-prefixes
+// flag or, without the flag, the given prefixes, defaulting to tf-acc-test-.
+func testSweepResourceNamePrefixes(prefixes ...string) []string {
+	if *flagSweepPrefixes != "" {
+		prefixes = strings.Split(*flagSweepPrefixes, ",")
+	}
+	if len(prefixes) == 0 {
+		prefixes = []string{defaultSweepResourceNamePrefix}
+	}
+
+	var result []string
+	for _, prefix := range prefixes {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			result = append(result, prefix)
+		}
+	}
+
+	return result
+}
+
+// testSweepSkipResourceName returns true if the resource name doesn't start
+// with one of the prefixes returned by testSweepResourceNamePrefixes.
+func testSweepSkipResourceName(name string, prefixes ...string) bool {
+	for _, prefix := range testSweepResourceNamePrefixes(prefixes...) {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// testSweepTagValueFilterValues returns the values of an EC2 tag-value filter
+// matching the prefixes returned by testSweepResourceNamePrefixes.
+func testSweepTagValueFilterValues(prefixes ...string) []*string {
+	var values []*string
+	for _, prefix := range testSweepResourceNamePrefixes(prefixes...) {
+		values = append(values, aws.String(prefix+"*"))
+	}
+
+	return values
+}
+
+// testSweepDryRunHandler skips the AWS API calls which change resources,
+// logging them instead and returning an empty response.
+var testSweepDryRunHandler = request.NamedHandler{
+	Name: "terraform.SweeperDryRunHandler",
+	Fn: func(r *request.Request) {
+		if isReadOnlyOperation(r.Operation.Name) {
+			return
+		}
+
+		log.Printf("[INFO] Sweeper dry run, skipping %s.%s: %s", r.ClientInfo.ServiceName, r.Operation.Name, r.Params)
+
+		r.Handlers.Send = request.HandlerList{}
+		r.Handlers.Send.PushBack(func(r *request.Request) {
+			r.HTTPResponse = &http.Response{
+				Status:     http.StatusText(http.StatusOK),
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			}
+		})
+		r.Handlers.UnmarshalMeta = request.HandlerList{}
+		r.Handlers.Unmarshal = request.HandlerList{}
+		r.Handlers.ValidateResponse = request.HandlerList{}
+	},
+}
+
 // sharedClientForRegion returns a common AWSClient setup needed for the sweeper
 // functions for a given region
 func sharedClientForRegion(region string) (interface{}, error) {
-	if os.Getenv("AWS_ACCESS_KEY_ID") == "" {
-		return nil, fmt.Errorf("empty AWS_ACCESS_KEY_ID")
+	conf := &Config{
+		Region: region,
 	}
 
-	if os.Getenv("AWS_SECRET_ACCESS_KEY") == "" {
-		return nil, fmt.Errorf("empty AWS_SECRET_ACCESS_KEY")
+	if v := os.Getenv(sweeperEndpointsEnvVar); v != "" {
+		endpoints, err := parseSweeperEndpoints(v)
+		if err != nil {
+			return nil, err
+		}
+
+		// a local stand-in for AWS accepts any credentials and has no
+		// account or metadata API
+		conf.Endpoints = endpoints
+		conf.SkipCredsValidation = true
+		conf.SkipGetEC2Platforms = true
+		conf.SkipMetadataApiCheck = true
+		conf.SkipRegionValidation = true
+		conf.SkipRequestingAccountId = true
+
+		if os.Getenv("AWS_ACCESS_KEY_ID") == "" {
+			conf.AccessKey = "mock_access_key"
+			conf.SecretKey = "mock_secret_key"
+		}
+	} else {
+		if os.Getenv("AWS_ACCESS_KEY_ID") == "" {
+			return nil, fmt.Errorf("empty AWS_ACCESS_KEY_ID")
+		}
+
+		if os.Getenv("AWS_SECRET_ACCESS_KEY") == "" {
+			return nil, fmt.Errorf("empty AWS_SECRET_ACCESS_KEY")
+		}
 	}
 
-	conf := &Config{
-		Region: region,
+	if *flagSweepDryRun {
+		conf.validateHandlers = append(conf.validateHandlers, testSweepDryRunHandler)
 	}
 
 	// configures a default client for the region, using the above env vars
 	client, err := conf.Client()
 	if err != nil {
-		return nil, fmt.Errorf("error getting AWS client")
+		return nil, fmt.Errorf("error getting AWS client: %s", err)
 	}
 
 	return client, nil
 }
+
+// parseSweeperEndpoints returns the endpoints by service of the
+// AWS_SWEEPER_ENDPOINTS environment variable.
+func parseSweeperEndpoints(v string) (map[string]string, error) {
+	endpoints := make(map[string]string)
+
+	for _, e := range strings.Split(v, ",") {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+
+		parts := strings.SplitN(e, "=", 2)
+		if len(parts) == 1 {
+			for _, service := range endpointServiceNames {
+				if _, ok := endpoints[service]; !ok {
+					endpoints[service] = parts[0]
+				}
+			}
+			continue
+		}
+
+		service, endpoint := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		supported := false
+		for _, s := range endpointServiceNames {
+			if s == service {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return nil, fmt.Errorf("%s: unsupported service %q", sweeperEndpointsEnvVar, service)
+		}
+		endpoints[service] = endpoint
+	}
+
+	return endpoints, nil
+}
+
+func TestTestSweeperOrder(t *testing.T) {
+	order, err := testSweeperOrder("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(order) != len(testSweepers) {
+		t.Fatalf("expected %d sweepers, got %d", len(testSweepers), len(order))
+	}
+
+	position := make(map[string]int)
+	for i, name := range order {
+		position[name] = i
+	}
+	for name, s := range testSweepers {
+		for _, dep := range s.Dependencies {
+			if position[dep] > position[name] {
+				t.Fatalf("expected sweeper (%s) to run after its dependency (%s)", name, dep)
+			}
+		}
+	}
+
+	order, err = testSweeperOrder("aws_vpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order[len(order)-1] != "aws_vpc" {
+		t.Fatalf("expected aws_vpc after its dependencies, got %v", order)
+	}
+	if len(order) <= len(testSweepers["aws_vpc"].Dependencies) {
+		t.Fatalf("expected aws_vpc dependencies, got %v", order)
+	}
+}
+
+func TestTestSweeperOrder_invalidDependencies(t *testing.T) {
+	sweepers := testSweepers
+	defer func() { testSweepers = sweepers }()
+
+	testSweepers = map[string]*resource.Sweeper{
+		"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}},
+	}
+	if _, err := testSweeperOrder(""); err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Fatalf("expected a dependency cycle error, got: %v", err)
+	}
+
+	testSweepers = map[string]*resource.Sweeper{
+		"aws_a": {Name: "aws_a", Dependencies: []string{"aws_c"}},
+	}
+	if _, err := testSweeperOrder(""); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected a missing dependency error, got: %v", err)
+	}
+}
+
+func TestTestSweepSkipResourceName(t *testing.T) {
+	defer func(v string) { *flagSweepPrefixes = v }(*flagSweepPrefixes)
+
+	cases := []struct {
+		Prefixes     string
+		Name         string
+		NamePrefixes []string
+		Skip         bool
+	}{
+		{Name: "tf-acc-test-123", Skip: false},
+		{Name: "production", Skip: true},
+		{Name: "tf-acc-test-123", NamePrefixes: []string{"tf_acc_"}, Skip: true},
+		{Name: "tf_acc_123", NamePrefixes: []string{"tf-acc-test-", "tf_acc_"}, Skip: false},
+		{Prefixes: "ci-, nightly-", Name: "nightly-123", Skip: false},
+		{Prefixes: "ci-, nightly-", Name: "tf-acc-test-123", Skip: true},
+	}
+
+	for i, tc := range cases {
+		*flagSweepPrefixes = tc.Prefixes
+		if skip := testSweepSkipResourceName(tc.Name, tc.NamePrefixes...); skip != tc.Skip {
+			t.Fatalf("%d: expected skip %t for %q, got %t", i, tc.Skip, tc.Name, skip)
+		}
+	}
+}
+
+func TestParseSweeperEndpoints(t *testing.T) {
+	endpoints, err := parseSweeperEndpoints("iam=http://localhost:4593, http://localhost:4566")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(endpoints) != len(endpointServiceNames) {
+		t.Fatalf("expected an endpoint for every service, got %d", len(endpoints))
+	}
+	if endpoints["iam"] != "http://localhost:4593" {
+		t.Fatalf("expected the iam endpoint, got %q", endpoints["iam"])
+	}
+	if endpoints["ec2"] != "http://localhost:4566" {
+		t.Fatalf("expected the default endpoint for ec2, got %q", endpoints["ec2"])
+	}
+
+	if _, err := parseSweeperEndpoints("nosuchservice=http://localhost:4566"); err == nil {
+		t.Fatal("expected an error for an unsupported service, got none")
+	}
+}
+
+func TestSweeperMockEndpoint(t *testing.T) {
+	var deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "AWSGlue.GetCrawlers":
+			w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+			fmt.Fprint(w, `{"Crawlers":[{"Name":"tf-acc-test-crawler"},{"Name":"production-crawler"}]}`)
+		case "AWSGlue.DeleteCrawler":
+			deleted = append(deleted, string(body))
+			w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(400)
+		}
+	}))
+	defer ts.Close()
+
+	defer unsetEnv(t)()
+	defer os.Unsetenv(sweeperEndpointsEnvVar)
+	if err := os.Setenv(sweeperEndpointsEnvVar, "glue="+ts.URL); err != nil {
+		t.Fatal(err)
+	}
+	defer func(v bool) { *flagSweepDryRun = v }(*flagSweepDryRun)
+
+	*flagSweepDryRun = true
+	if err := testSweepGlueCrawlers("us-west-2"); err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("expected no deleted crawlers in dry run, got %v", deleted)
+	}
+
+	*flagSweepDryRun = false
+	if err := testSweepGlueCrawlers("us-west-2"); err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 1 || deleted[0] != `{"Name":"tf-acc-test-crawler"}` {
+		t.Fatalf("expected to delete tf-acc-test-crawler, got %v", deleted)
+	}
+}
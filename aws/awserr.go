@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"log"
 	"strings"
 	"time"
 
@@ -32,14 +33,27 @@ func IsAWSErrExtended(err error, code string, message string, origErrMessage str
 	return strings.Contains(err.(awserr.Error).OrigErr().Error(), origErrMessage)
 }
 
-func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, error) {
+// retryOnAwsCode retries the AWS error code, and any additional retryable
+// error codes of the provider retry policy, for one minute
+func retryOnAwsCode(config *RetryConfig, code string, f func() (interface{}, error)) (interface{}, error) {
+	return RetryOnAwsCodes(config, []string{code}, f)
+}
+
+// RetryOnAwsCodes retries AWS error codes, and any additional retryable
+// error codes of the provider retry policy, for one minute. With a retry
+// policy, the attempts are spaced by its backoff.
+// Note: This function will be moved out of the aws package in the future.
+func RetryOnAwsCodes(config *RetryConfig, codes []string, f func() (interface{}, error)) (interface{}, error) {
+	if config != nil {
+		return retryOnAwsCodesWithPolicy(config, codes, 1*time.Minute, f)
+	}
+
 	var resp interface{}
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
 		var err error
 		resp, err = f()
 		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok && awsErr.Code() == code {
+			if isRetryableAwsErr(config, codes, err) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -49,25 +63,42 @@ func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, er
 	return resp, err
 }
 
-// RetryOnAwsCodes retries AWS error codes for one minute
-// Note: This function will be moved out of the aws package in the future.
-func RetryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}, error) {
-	var resp interface{}
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = f()
-		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok {
-				for _, code := range codes {
-					if awsErr.Code() == code {
-						return resource.RetryableError(err)
-					}
-				}
-			}
-			return resource.NonRetryableError(err)
+// retryOnAwsCodesWithPolicy retries AWS error codes until the timeout,
+// following the backoff of the retry policy. The maximum attempts of the
+// policy only apply to API calls, eventual consistency is waited out until
+// the timeout.
+func retryOnAwsCodesWithPolicy(config *RetryConfig, codes []string, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 1; ; attempt++ {
+		resp, err := f()
+		if err == nil || !isRetryableAwsErr(config, codes, err) {
+			return resp, err
 		}
-		return nil
-	})
-	return resp, err
+
+		delay := config.backoff(attempt - 1)
+		if time.Now().Add(delay).After(deadline) {
+			return resp, err
+		}
+
+		log.Printf("[DEBUG] Retrying in %s after attempt %d: %s", delay, attempt, err)
+		time.Sleep(delay)
+	}
+}
+
+// isRetryableAwsErr returns whether the error is an AWS error with one of
+// the codes or one of the additional retryable error codes of the policy.
+func isRetryableAwsErr(config *RetryConfig, codes []string, err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+
+	for _, code := range codes {
+		if awsErr.Code() == code {
+			return true
+		}
+	}
+
+	return config.isRetryableErrorCode(awsErr.Code())
 }
@@ -0,0 +1,420 @@
+package aws
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	// CassetteModeRecord records every AWS API request and response.
+	CassetteModeRecord = "record"
+	// CassetteModeReplay serves the recorded responses instead of calling AWS.
+	CassetteModeReplay = "replay"
+
+	cassetteModeEnvVar      = "TERRAFORM_AWS_CASSETTE_MODE"
+	cassetteFileEnvVar      = "TERRAFORM_AWS_CASSETTE_FILE"
+	cassetteRecordingEnvVar = "TERRAFORM_AWS_CASSETTE_RECORDING"
+)
+
+// cassetteVolatileParameters are request parameters with values generated by
+// the SDK or the provider on every call, ignored when matching requests.
+var cassetteVolatileParameters = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+}
+
+// cassetteResponseRedactedFields are the fields of recorded responses
+// holding credentials, which are never used on replay. Other secrets are kept
+// in the responses as resources read them back into their state.
+var cassetteResponseRedactedFields = []string{
+	"SecretAccessKey",
+	"SessionToken",
+}
+
+var (
+	cassetteResponseFieldRedactor = newFieldRedactor(cassetteResponseRedactedFields)
+	cassetteResponseTextRedactor  = newLogMessageRedactor(cassetteResponseRedactedFields)
+)
+
+// cassetteInteraction is a recorded AWS API request and its response.
+type cassetteInteraction struct {
+	Recording string              `json:"recording,omitempty"`
+	Service   string              `json:"service"`
+	Operation string              `json:"operation"`
+	Request   string              `json:"request"`
+	Status    int                 `json:"status"`
+	Header    map[string][]string `json:"header"`
+	Body      string              `json:"body"`
+}
+
+// cassette is a file of recorded interactions, one JSON document per line.
+//
+// Terraform starts a provider process for each step of a command, so a
+// recording is made of the interactions appended by every provider process of
+// the same Terraform command, identified by the process ID and start time of
+// their parent unless set in the environment. Recording again into an existing
+// file appends a new recording, which replay prefers.
+//
+// Secrets in the requests are redacted before they are written to the file,
+// the recorded requests only being used for matching. Responses only have the
+// credentials returned by STS and IAM redacted.
+type cassette struct {
+	mode      string
+	path      string
+	recording string
+	redactor  fieldRedactor
+
+	mu           sync.Mutex
+	interactions []*cassetteInteraction
+	used         map[*cassetteInteraction]bool
+
+	// latest is the index of the first interaction of the latest recording.
+	latest int
+}
+
+// cassettes are shared by the provider configurations using the same file.
+var cassettes = struct {
+	sync.Mutex
+	m map[string]*cassette
+}{m: make(map[string]*cassette)}
+
+// cassetteFromEnv returns the cassette configured in the environment, if any.
+func cassetteFromEnv() (*cassette, error) {
+	mode := os.Getenv(cassetteModeEnvVar)
+	if mode == "" {
+		return nil, nil
+	}
+
+	path := os.Getenv(cassetteFileEnvVar)
+	if path == "" {
+		return nil, fmt.Errorf("%s must be set with %s", cassetteFileEnvVar, cassetteModeEnvVar)
+	}
+
+	cassettes.Lock()
+	defer cassettes.Unlock()
+
+	if c, ok := cassettes.m[path]; ok && c.mode == mode {
+		return c, nil
+	}
+
+	c, err := newCassette(mode, path)
+	if err != nil {
+		return nil, err
+	}
+	cassettes.m[path] = c
+
+	return c, nil
+}
+
+func newCassette(mode, path string) (*cassette, error) {
+	c := &cassette{
+		mode:     mode,
+		path:     path,
+		redactor: newFieldRedactor(defaultRedactedFields),
+		used:     make(map[*cassetteInteraction]bool),
+	}
+
+	switch mode {
+	case CassetteModeRecord:
+		c.recording = cassetteRecordingID()
+		return c, nil
+	case CassetteModeReplay:
+		if err := c.load(); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+
+	return nil, fmt.Errorf("%s must be one of %q or %q, got %q", cassetteModeEnvVar, CassetteModeRecord, CassetteModeReplay, mode)
+}
+
+func (c *cassette) load() error {
+	f, err := os.Open(c.path)
+	if err != nil {
+		return fmt.Errorf("error opening cassette (%s): %s", c.path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		interaction := &cassetteInteraction{}
+		if err := json.Unmarshal(scanner.Bytes(), interaction); err != nil {
+			return fmt.Errorf("error reading cassette (%s): %s", c.path, err)
+		}
+		c.interactions = append(c.interactions, interaction)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading cassette (%s): %s", c.path, err)
+	}
+
+	// The latest recording is the last run of interactions recorded by the
+	// same Terraform command.
+	c.latest = len(c.interactions)
+	for c.latest > 0 && c.interactions[c.latest-1].Recording == c.interactions[len(c.interactions)-1].Recording {
+		c.latest--
+	}
+
+	log.Printf("[INFO] Loaded %d AWS API interactions from cassette %s, %d of them in the latest recording",
+		len(c.interactions), c.path, len(c.interactions)-c.latest)
+	return nil
+}
+
+// addHandlers installs the record or replay handlers on the session.
+func (c *cassette) addHandlers(sess *session.Session) {
+	log.Printf("[INFO] AWS API requests in %s mode with cassette %s", c.mode, c.path)
+
+	switch c.mode {
+	case CassetteModeRecord:
+		sess.Handlers.Send.PushBackNamed(request.NamedHandler{
+			Name: "terraform.CassetteRecordHandler",
+			Fn:   c.record,
+		})
+	case CassetteModeReplay:
+		sess.Handlers.Send.Clear()
+		sess.Handlers.Send.PushBackNamed(request.NamedHandler{
+			Name: "terraform.CassetteReplayHandler",
+			Fn:   c.replay,
+		})
+	}
+}
+
+// record appends the request and its response to the cassette file.
+func (c *cassette) record(r *request.Request) {
+	if r.Error != nil || r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	r.HTTPResponse.Body.Close()
+	if err != nil {
+		r.Error = awserr.New(request.ErrCodeRead, "failed reading response body for cassette", err)
+		return
+	}
+	r.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	interaction := &cassetteInteraction{
+		Recording: c.recording,
+		Service:   r.ClientInfo.ServiceName,
+		Operation: r.Operation.Name,
+		Request:   c.request(r),
+		Status:    r.HTTPResponse.StatusCode,
+		Header:    r.HTTPResponse.Header,
+		Body:      redactCassetteBody(cassetteResponseFieldRedactor, cassetteResponseTextRedactor, string(body)),
+	}
+
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		log.Printf("[WARN] Error encoding %s.%s interaction for cassette: %s", interaction.Service, interaction.Operation, err)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	f, err := os.OpenFile(c.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Printf("[WARN] Error opening cassette (%s): %s", c.path, err)
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] Error writing cassette (%s): %s", c.path, err)
+	}
+}
+
+// replay serves the response of the first unused matching interaction of the
+// latest recording. Once all matching interactions are used, the last one is
+// served again. Earlier recordings are only used for requests the latest
+// recording has no interaction for.
+func (c *cassette) replay(r *request.Request) {
+	service, operation, req := r.ClientInfo.ServiceName, r.Operation.Name, c.request(r)
+
+	c.mu.Lock()
+	interaction := c.match(c.interactions[c.latest:], service, operation, req)
+	if interaction == nil {
+		interaction = c.match(c.interactions[:c.latest], service, operation, req)
+		if interaction != nil {
+			log.Printf("[WARN] Replaying %s.%s interaction from an earlier recording of cassette %s", service, operation, c.path)
+		}
+	}
+	c.mu.Unlock()
+
+	if interaction == nil {
+		r.Error = awserr.New("CassetteInteractionNotFound",
+			fmt.Sprintf("no recorded %s.%s interaction matches request: %s", service, operation, req), nil)
+		r.Retryable = aws.Bool(false)
+		return
+	}
+
+	log.Printf("[DEBUG] Replaying %s.%s interaction from cassette", service, operation)
+	r.HTTPResponse = &http.Response{
+		Status:     http.StatusText(interaction.Status),
+		StatusCode: interaction.Status,
+		Header:     http.Header(interaction.Header),
+		Body:       ioutil.NopCloser(strings.NewReader(interaction.Body)),
+	}
+}
+
+// match returns the first unused interaction matching the request, or the last
+// matching one once all are used, and marks it as used.
+func (c *cassette) match(interactions []*cassetteInteraction, service, operation, req string) *cassetteInteraction {
+	var interaction *cassetteInteraction
+	for _, i := range interactions {
+		if i.Service != service || i.Operation != operation || i.Request != req {
+			continue
+		}
+		interaction = i
+		if !c.used[i] {
+			break
+		}
+	}
+	if interaction != nil {
+		c.used[interaction] = true
+	}
+
+	return interaction
+}
+
+// request returns the request path, query and body with the volatile
+// parameters removed and the secrets redacted, used to match requests with
+// recorded interactions.
+func (c *cassette) request(r *request.Request) string {
+	var body []byte
+	if r.Body != nil {
+		if _, err := r.Body.Seek(r.BodyStart, io.SeekStart); err == nil {
+			body, _ = ioutil.ReadAll(r.Body)
+			r.Body.Seek(r.BodyStart, io.SeekStart)
+		}
+	}
+
+	service, operation := r.ClientInfo.ServiceName, r.Operation.Name
+	fields := c.redactor.withOperation(service, operation)
+	text := operationLogMessageRedactor(service + "/" + operation)
+
+	return fmt.Sprintf("%s %s?%s %s", r.HTTPRequest.Method, r.HTTPRequest.URL.EscapedPath(),
+		redactCassetteBody(fields, text, normalizeCassetteQuery(r.HTTPRequest.URL.RawQuery)),
+		redactCassetteBody(fields, text, normalizeCassetteBody(body)))
+}
+
+// redactCassetteBody masks the values of the redacted fields in a JSON, XML or
+// form encoded body.
+func redactCassetteBody(fields fieldRedactor, text *logMessageRedactor, body string) string {
+	trimmed := strings.TrimSpace(body)
+	if strings.HasPrefix(trimmed, "{") {
+		var v interface{}
+		decoder := json.NewDecoder(strings.NewReader(trimmed))
+		decoder.UseNumber()
+		if err := decoder.Decode(&v); err == nil {
+			if b, err := json.Marshal(fields.redact(v)); err == nil {
+				return string(b)
+			}
+		}
+	}
+
+	return text.redact(body)
+}
+
+// cassetteRecordingID returns the ID of the recording made by the provider
+// process, set in the environment or otherwise made of the process ID and
+// start time of the parent Terraform process.
+func cassetteRecordingID() string {
+	if id := os.Getenv(cassetteRecordingEnvVar); id != "" {
+		return id
+	}
+
+	ppid := os.Getppid()
+	if start := processStartTime(ppid); start != "" {
+		return fmt.Sprintf("%d-%s", ppid, start)
+	}
+
+	return strconv.Itoa(ppid)
+}
+
+// processStartTime returns the start time of the process in clock ticks since
+// boot, or an empty string where it is not available from /proc.
+func processStartTime(pid int) string {
+	stat, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return ""
+	}
+
+	// The command name in parentheses may contain spaces. The start time is
+	// the 20th field after it.
+	i := bytes.LastIndexByte(stat, ')')
+	if i < 0 {
+		return ""
+	}
+	fields := strings.Fields(string(stat[i+1:]))
+	if len(fields) < 20 {
+		return ""
+	}
+
+	return fields[19]
+}
+
+func normalizeCassetteQuery(query string) string {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return query
+	}
+	for _, p := range cassetteVolatileParameters {
+		values.Del(p)
+	}
+
+	return values.Encode()
+}
+
+// normalizeCassetteBody returns JSON bodies with sorted keys and form encoded
+// bodies with sorted parameters, without the volatile parameters.
+func normalizeCassetteBody(body []byte) string {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return ""
+	}
+
+	if trimmed[0] == '{' {
+		var v map[string]interface{}
+		if err := json.Unmarshal(trimmed, &v); err == nil {
+			for _, p := range cassetteVolatileParameters {
+				delete(v, p)
+			}
+			if b, err := json.Marshal(v); err == nil {
+				return string(b)
+			}
+		}
+		return string(trimmed)
+	}
+
+	if trimmed[0] != '<' {
+		if values, err := url.ParseQuery(string(trimmed)); err == nil {
+			for _, p := range cassetteVolatileParameters {
+				values.Del(p)
+			}
+			return values.Encode()
+		}
+	}
+
+	return string(trimmed)
+}
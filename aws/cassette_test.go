@@ -0,0 +1,295 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraform_aws_cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.jsonl")
+
+	// Record
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=GetCallerIdentity&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsResponse_GetCallerIdentity_valid, "text/xml"},
+		},
+	})
+	if err != nil {
+		closeSts()
+		t.Fatal(err)
+	}
+
+	recorder, err := newCassette(CassetteModeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder.addHandlers(stsSess)
+
+	accountID, _, err := GetAccountIDAndPartitionFromSTSGetCallerIdentity(sts.New(stsSess))
+	closeSts()
+	if err != nil {
+		t.Fatalf("Error recording sts:GetCallerIdentity: %s", err)
+	}
+	if accountID != stsResponse_GetCallerIdentity_valid_expectedAccountID {
+		t.Fatalf("Recorded account ID doesn't match (%q != %q)", accountID, stsResponse_GetCallerIdentity_valid_expectedAccountID)
+	}
+
+	// Replay against a server that is no longer running
+	closeSts, stsSess, err = getMockedAwsApiSession("STS", []*awsMockEndpoint{})
+	closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	player, err := newCassette(CassetteModeReplay, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(player.interactions) != 1 {
+		t.Fatalf("Expected 1 recorded interaction, got %d", len(player.interactions))
+	}
+	player.addHandlers(stsSess)
+
+	for i := 0; i < 2; i++ {
+		accountID, _, err = GetAccountIDAndPartitionFromSTSGetCallerIdentity(sts.New(stsSess))
+		if err != nil {
+			t.Fatalf("Error replaying sts:GetCallerIdentity: %s", err)
+		}
+		if accountID != stsResponse_GetCallerIdentity_valid_expectedAccountID {
+			t.Fatalf("Replayed account ID doesn't match (%q != %q)", accountID, stsResponse_GetCallerIdentity_valid_expectedAccountID)
+		}
+	}
+
+	_, err = sts.New(stsSess).GetSessionToken(&sts.GetSessionTokenInput{DurationSeconds: aws.Int64(900)})
+	if !isAWSErr(err, "CassetteInteractionNotFound", "") {
+		t.Fatalf("Expected CassetteInteractionNotFound error for an unrecorded request, got: %v", err)
+	}
+}
+
+func TestCassetteReplayLatestRecording(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraform_aws_cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.jsonl")
+
+	// Record twice into the same file, as two Terraform commands would
+	accountIDs := []string{"111111111111", stsResponse_GetCallerIdentity_valid_expectedAccountID}
+	for i, expectedAccountID := range accountIDs {
+		closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+			{
+				Request: &awsMockRequest{"POST", "/", "Action=GetCallerIdentity&Version=2011-06-15"},
+				Response: &awsMockResponse{200, strings.Replace(stsResponse_GetCallerIdentity_valid,
+					stsResponse_GetCallerIdentity_valid_expectedAccountID, expectedAccountID, -1), "text/xml"},
+			},
+		})
+		if err != nil {
+			closeSts()
+			t.Fatal(err)
+		}
+
+		recorder, err := newCassette(CassetteModeRecord, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		recorder.recording = fmt.Sprintf("recording-%d", i)
+		recorder.addHandlers(stsSess)
+
+		_, _, err = GetAccountIDAndPartitionFromSTSGetCallerIdentity(sts.New(stsSess))
+		closeSts()
+		if err != nil {
+			t.Fatalf("Error recording sts:GetCallerIdentity: %s", err)
+		}
+	}
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{})
+	closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	player, err := newCassette(CassetteModeReplay, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(player.interactions) != 2 {
+		t.Fatalf("Expected 2 recorded interactions, got %d", len(player.interactions))
+	}
+	player.addHandlers(stsSess)
+
+	for i := 0; i < 2; i++ {
+		accountID, _, err := GetAccountIDAndPartitionFromSTSGetCallerIdentity(sts.New(stsSess))
+		if err != nil {
+			t.Fatalf("Error replaying sts:GetCallerIdentity: %s", err)
+		}
+		if accountID != stsResponse_GetCallerIdentity_valid_expectedAccountID {
+			t.Fatalf("Expected the account ID of the latest recording (%q), got %q", stsResponse_GetCallerIdentity_valid_expectedAccountID, accountID)
+		}
+	}
+}
+
+func TestCassetteRedactsSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraform_aws_cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.jsonl")
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String("arn:aws:iam::555555555555:role/web-identity"),
+		RoleSessionName:  aws.String("terraform"),
+		WebIdentityToken: aws.String("mock-token"),
+	}
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRoleWithWebIdentity" +
+				"&RoleArn=arn%3Aaws%3Aiam%3A%3A555555555555%3Arole%2Fweb-identity&RoleSessionName=terraform" +
+				"&Version=2011-06-15&WebIdentityToken=mock-token"},
+			Response: &awsMockResponse{200, stsResponse_AssumeRoleWithWebIdentity_valid, "text/xml"},
+		},
+	})
+	if err != nil {
+		closeSts()
+		t.Fatal(err)
+	}
+
+	recorder, err := newCassette(CassetteModeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder.addHandlers(stsSess)
+
+	output, err := sts.New(stsSess).AssumeRoleWithWebIdentity(input)
+	closeSts()
+	if err != nil {
+		t.Fatalf("Error recording sts:AssumeRoleWithWebIdentity: %s", err)
+	}
+	if v := aws.StringValue(output.Credentials.SecretAccessKey); v != "webIdentitySecretKey" {
+		t.Fatalf("Expected the recorded request to return the secret access key, got %q", v)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"mock-token", "webIdentitySecretKey", "webIdentitySessionToken"} {
+		if strings.Contains(string(content), secret) {
+			t.Fatalf("Expected %q to be redacted from the cassette, got: %s", secret, content)
+		}
+	}
+
+	closeSts, stsSess, err = getMockedAwsApiSession("STS", []*awsMockEndpoint{})
+	closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	player, err := newCassette(CassetteModeReplay, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	player.addHandlers(stsSess)
+
+	output, err = sts.New(stsSess).AssumeRoleWithWebIdentity(input)
+	if err != nil {
+		t.Fatalf("Error replaying sts:AssumeRoleWithWebIdentity: %s", err)
+	}
+	if v := aws.StringValue(output.Credentials.AccessKeyId); v != "webIdentityAccessKey" {
+		t.Fatalf("AccessKeyId mismatch, expected: (webIdentityAccessKey), got (%s)", v)
+	}
+	if v := aws.StringValue(output.Credentials.SecretAccessKey); v != redactedValue {
+		t.Fatalf("SecretAccessKey mismatch, expected: (%s), got (%s)", redactedValue, v)
+	}
+}
+
+func TestCassetteResponseRedaction(t *testing.T) {
+	cases := []struct {
+		Body     string
+		Expected string
+	}{
+		{
+			Body:     `{"Name":"test","SecretBinary":"dGVzdA==","SecretString":"secret"}`,
+			Expected: `{"Name":"test","SecretBinary":"dGVzdA==","SecretString":"secret"}`,
+		},
+		{
+			Body:     `{"Credentials":{"AccessKeyId":"key","SecretAccessKey":"secret","SessionToken":"token"}}`,
+			Expected: `{"Credentials":{"AccessKeyId":"key","SecretAccessKey":"***","SessionToken":"***"}}`,
+		},
+		{
+			Body:     "<Credentials><AccessKeyId>key</AccessKeyId><SecretAccessKey>secret</SecretAccessKey></Credentials>",
+			Expected: "<Credentials><AccessKeyId>key</AccessKeyId><SecretAccessKey>***</SecretAccessKey></Credentials>",
+		},
+	}
+
+	for i, tc := range cases {
+		if got := redactCassetteBody(cassetteResponseFieldRedactor, cassetteResponseTextRedactor, tc.Body); got != tc.Expected {
+			t.Fatalf("%d: expected %q, got %q", i, tc.Expected, got)
+		}
+	}
+}
+
+func TestCassetteRecordingID(t *testing.T) {
+	defer os.Setenv(cassetteRecordingEnvVar, os.Getenv(cassetteRecordingEnvVar))
+
+	os.Setenv(cassetteRecordingEnvVar, "run-1")
+	if id := cassetteRecordingID(); id != "run-1" {
+		t.Fatalf("Expected the recording ID from the environment, got %q", id)
+	}
+
+	os.Unsetenv(cassetteRecordingEnvVar)
+	if id := cassetteRecordingID(); !strings.HasPrefix(id, fmt.Sprintf("%d", os.Getppid())) {
+		t.Fatalf("Expected the recording ID to start with the parent process ID, got %q", id)
+	}
+}
+
+func TestCassetteInvalidMode(t *testing.T) {
+	if _, err := newCassette("rewind", "cassette.jsonl"); err == nil {
+		t.Fatal("Expected an error for an invalid cassette mode, got none")
+	}
+}
+
+func TestNormalizeCassetteBody(t *testing.T) {
+	cases := []struct {
+		Body     string
+		Expected string
+	}{
+		{
+			Body:     "",
+			Expected: "",
+		},
+		{
+			Body:     "Version=2016-11-15&Action=RunInstances&ClientToken=abc123&MaxCount=1",
+			Expected: "Action=RunInstances&MaxCount=1&Version=2016-11-15",
+		},
+		{
+			Body:     `{"Name":"test","ClientRequestToken":"abc123","Description":"foo"}`,
+			Expected: `{"Description":"foo","Name":"test"}`,
+		},
+		{
+			Body:     "<CreateHostedZoneRequest><Name>example.com</Name></CreateHostedZoneRequest>",
+			Expected: "<CreateHostedZoneRequest><Name>example.com</Name></CreateHostedZoneRequest>",
+		},
+	}
+
+	for i, tc := range cases {
+		if got := normalizeCassetteBody([]byte(tc.Body)); got != tc.Expected {
+			t.Fatalf("%d: expected %q, got %q", i, tc.Expected, got)
+		}
+	}
+}
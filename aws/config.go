@@ -2,10 +2,12 @@ package aws
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -114,43 +116,38 @@ type Config struct {
 	Token         string
 	Region        string
 	MaxRetries    int
+	RetryConfig   *RetryConfig
+	RateLimits    map[string]RateLimit
 
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	AuditLogConfig *AuditLogConfig
+
+	AssumeRoleARN               string
+	AssumeRoleExternalID        string
+	AssumeRoleSessionName       string
+	AssumeRolePolicy            string
+	AssumeRoleDurationSeconds   int
+	AssumeRoleTags              map[string]string
+	AssumeRoleTransitiveTagKeys []string
+	AssumeRoleChainARNs         []string
+
+	AssumeRoleWithWebIdentityARN             string
+	AssumeRoleWithWebIdentitySessionName     string
+	AssumeRoleWithWebIdentityTokenFile       string
+	AssumeRoleWithWebIdentityDurationSeconds int
 
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
-	AcmEndpoint              string
-	ApigatewayEndpoint       string
-	CloudFormationEndpoint   string
-	CloudWatchEndpoint       string
-	CloudWatchEventsEndpoint string
-	CloudWatchLogsEndpoint   string
-	DynamoDBEndpoint         string
-	DeviceFarmEndpoint       string
-	Ec2Endpoint              string
-	EcsEndpoint              string
-	AutoscalingEndpoint      string
-	EcrEndpoint              string
-	EfsEndpoint              string
-	EsEndpoint               string
-	ElbEndpoint              string
-	IamEndpoint              string
-	KinesisEndpoint          string
-	KinesisAnalyticsEndpoint string
-	KmsEndpoint              string
-	LambdaEndpoint           string
-	RdsEndpoint              string
-	R53Endpoint              string
-	S3Endpoint               string
-	SnsEndpoint              string
-	SqsEndpoint              string
-	StsEndpoint              string
-	SsmEndpoint              string
-	Insecure                 bool
+	DefaultTags      map[string]interface{}
+	IgnoreTagsConfig *IgnoreTagsConfig
+
+	Endpoints map[string]string
+	Insecure  bool
+
+	HTTPProxy         string
+	CustomCABundle    string
+	ClientCertificate string
+	ClientPrivateKey  string
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -158,6 +155,10 @@ type Config struct {
 	SkipRequestingAccountId bool
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
+
+	// validateHandlers are added to the request handlers of every service
+	// client, run before the request is built. Used by the test sweepers.
+	validateHandlers []request.NamedHandler
 }
 
 type AWSClient struct {
@@ -206,6 +207,9 @@ type AWSClient struct {
 	accountid             string
 	supportedplatforms    []string
 	region                string
+	defaultTags           map[string]interface{}
+	ignoreTagsConfig      *IgnoreTagsConfig
+	retryConfig           *RetryConfig
 	rdsconn               *rds.RDS
 	iamconn               *iam.IAM
 	kinesisconn           *kinesis.Kinesis
@@ -223,6 +227,7 @@ type AWSClient struct {
 	macieconn             *macie.Macie
 	mqconn                *mq.MQ
 	opsworksconn          *opsworks.OpsWorks
+	opsworkssess          *session.Session
 	organizationsconn     *organizations.Organizations
 	glacierconn           *glacier.Glacier
 	guarddutyconn         *guardduty.GuardDuty
@@ -283,6 +288,27 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	client.defaultTags = c.DefaultTags
+	client.retryConfig = c.RetryConfig
+
+	// Tags reserved for use by AWS are always ignored, along with any keys
+	// and key prefixes configured in the provider ignore_tags block.
+	client.ignoreTagsConfig = &IgnoreTagsConfig{
+		KeyPrefixes: append([]string{}, systemIgnoreTagsConfig.KeyPrefixes...),
+	}
+	if c.IgnoreTagsConfig != nil {
+		client.ignoreTagsConfig.Keys = append(client.ignoreTagsConfig.Keys, c.IgnoreTagsConfig.Keys...)
+		client.ignoreTagsConfig.KeyPrefixes = append(client.ignoreTagsConfig.KeyPrefixes, c.IgnoreTagsConfig.KeyPrefixes...)
+	}
+
+	// custom_ca_bundle is sourced from AWS_CA_BUNDLE and trusted in addition
+	// to the system certificates by httpClient. The SDK sessions would
+	// otherwise read the environment variable again as a file path and only
+	// trust its certificates, so it is hidden while they are built.
+	if v, ok := os.LookupEnv("AWS_CA_BUNDLE"); ok {
+		os.Unsetenv("AWS_CA_BUNDLE")
+		defer os.Setenv("AWS_CA_BUNDLE", v)
+	}
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
@@ -290,6 +316,11 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return nil, err
+	}
+
 	// define the AWS Session options
 	// Credentials or Profile will be set in the Options below
 	// MaxRetries may be set once we validate credentials
@@ -297,7 +328,7 @@ func (c *Config) Client() (interface{}, error) {
 		Config: aws.Config{
 			Region:           aws.String(c.Region),
 			MaxRetries:       aws.Int(0),
-			HTTPClient:       cleanhttp.DefaultClient(),
+			HTTPClient:       httpClient,
 			S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 		},
 	}
@@ -342,13 +373,6 @@ func (c *Config) Client() (interface{}, error) {
 		opt.Config.Logger = awsLogger{}
 	}
 
-	if c.Insecure {
-		transport := opt.Config.HTTPClient.Transport.(*http.Transport)
-		transport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: true,
-		}
-	}
-
 	// create base session with no retries. MaxRetries will be set later
 	sess, err := session.NewSessionWithOptions(opt)
 	if err != nil {
@@ -366,11 +390,38 @@ func (c *Config) Client() (interface{}, error) {
 		sess.Handlers.UnmarshalError.PushFrontNamed(debugAuthFailure)
 	}
 
+	// Record or replay AWS API interactions for offline testing
+	cassette, err := cassetteFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if cassette != nil {
+		cassette.addHandlers(sess)
+	}
+
+	for _, h := range c.validateHandlers {
+		sess.Handlers.Validate.PushBackNamed(h)
+	}
+
+	if c.AuditLogConfig != nil {
+		auditLogger, err := newAuditLogger(c.AuditLogConfig)
+		if err != nil {
+			return nil, err
+		}
+		sess.Handlers.Complete.PushBackNamed(auditLogger.handler())
+	}
+
 	// if the desired number of retries is non-zero, update the session
 	if c.MaxRetries > 0 {
 		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
 	}
 
+	// The retry block replaces the SDK default retryer. The retryOnAwsCode
+	// and RetryOnAwsCodes helpers get it from the client retryConfig.
+	if c.RetryConfig != nil {
+		sess = sess.Copy(request.WithRetryer(&aws.Config{}, newRetryer(c.MaxRetries, c.RetryConfig)))
+	}
+
 	// Generally, we want to configure a lower retry theshold for networking issues
 	// as the session retry threshold is very high by default and can mask permanent
 	// networking failures, such as a non-existent service endpoint.
@@ -401,49 +452,22 @@ func (c *Config) Client() (interface{}, error) {
 	// Other resources that have restrictions should allow the API to fail, rather
 	// than Terraform abstracting the region for the user. This can lead to breaking
 	// changes if that resource is ever opened up to more regions.
-	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.R53Endpoint)})
-
-	// Some services have user-configurable endpoints
-	awsAcmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.AcmEndpoint)})
-	awsApigatewaySess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ApigatewayEndpoint)})
-	awsCfSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})
-	awsCwSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEndpoint)})
-	awsCweSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEventsEndpoint)})
-	awsCwlSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchLogsEndpoint)})
-	awsDynamoSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DynamoDBEndpoint)})
-	awsEc2Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.Ec2Endpoint)})
-	awsAutoscalingSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.AutoscalingEndpoint)})
-	awsEcrSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EcrEndpoint)})
-	awsEcsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EcsEndpoint)})
-	awsEfsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EfsEndpoint)})
-	awsElbSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ElbEndpoint)})
-	awsEsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EsEndpoint)})
-	awsIamSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.IamEndpoint)})
-	awsLambdaSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.LambdaEndpoint)})
-	awsKinesisSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisEndpoint)})
-	awsKinesisAnalyticsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisAnalyticsEndpoint)})
-	awsKmsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KmsEndpoint)})
-	awsRdsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.RdsEndpoint)})
-	awsS3Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.S3Endpoint)})
-	awsSnsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SnsEndpoint)})
-	awsSqsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SqsEndpoint)})
-	awsStsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.StsEndpoint)})
-	awsDeviceFarmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DeviceFarmEndpoint)})
-	awsSsmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SsmEndpoint)})
+	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.Endpoints["r53"])})
+	c.addRateLimitHandler(r53Sess, "r53")
 
 	log.Println("[INFO] Initializing DeviceFarm SDK connection")
-	client.devicefarmconn = devicefarm.New(awsDeviceFarmSess)
+	client.devicefarmconn = devicefarm.New(c.endpointSession(sess, "devicefarm"))
 
 	// Beyond verifying credentials (if enabled), we use the next set of logic
 	// to determine two pieces of information required for manually assembling
 	// resource ARNs when they are not available in the service API:
 	//  * client.accountid
 	//  * client.partition
-	client.iamconn = iam.New(awsIamSess)
-	client.stsconn = sts.New(awsStsSess)
+	client.iamconn = iam.New(c.endpointSession(sess, "iam"))
+	client.stsconn = sts.New(c.endpointSession(sess, "sts"))
 
-	if c.AssumeRoleARN != "" {
-		client.accountid, client.partition, _ = parseAccountIDAndPartitionFromARN(c.AssumeRoleARN)
+	if roleARN := c.assumedRoleARN(); roleARN != "" {
+		client.accountid, client.partition, _ = parseAccountIDAndPartitionFromARN(roleARN)
 	}
 
 	// Validate credentials early and fail before we do any graph walking.
@@ -485,7 +509,7 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	client.ec2conn = ec2.New(awsEc2Sess)
+	client.ec2conn = ec2.New(c.endpointSession(sess, "ec2"))
 
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
@@ -498,88 +522,88 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	client.budgetconn = budgets.New(sess)
-	client.acmconn = acm.New(awsAcmSess)
-	client.acmpcaconn = acmpca.New(sess)
-	client.apigateway = apigateway.New(awsApigatewaySess)
-	client.appautoscalingconn = applicationautoscaling.New(sess)
-	client.autoscalingconn = autoscaling.New(awsAutoscalingSess)
-	client.cloud9conn = cloud9.New(sess)
-	client.cfconn = cloudformation.New(awsCfSess)
-	client.cloudfrontconn = cloudfront.New(sess)
-	client.cloudhsmv2conn = cloudhsmv2.New(sess)
-	client.cloudtrailconn = cloudtrail.New(sess)
-	client.cloudwatchconn = cloudwatch.New(awsCwSess)
-	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
-	client.cloudwatchlogsconn = cloudwatchlogs.New(awsCwlSess)
-	client.codecommitconn = codecommit.New(sess)
-	client.codebuildconn = codebuild.New(sess)
-	client.codedeployconn = codedeploy.New(sess)
-	client.configconn = configservice.New(sess)
-	client.cognitoconn = cognitoidentity.New(sess)
-	client.cognitoidpconn = cognitoidentityprovider.New(sess)
-	client.codepipelineconn = codepipeline.New(sess)
-	client.daxconn = dax.New(awsDynamoSess)
-	client.dlmconn = dlm.New(sess)
-	client.dmsconn = databasemigrationservice.New(sess)
-	client.dsconn = directoryservice.New(sess)
-	client.dynamodbconn = dynamodb.New(awsDynamoSess)
-	client.ecrconn = ecr.New(awsEcrSess)
-	client.ecsconn = ecs.New(awsEcsSess)
-	client.efsconn = efs.New(awsEfsSess)
-	client.eksconn = eks.New(sess)
-	client.elasticacheconn = elasticache.New(sess)
-	client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
-	client.elastictranscoderconn = elastictranscoder.New(sess)
-	client.elbconn = elb.New(awsElbSess)
-	client.elbv2conn = elbv2.New(awsElbSess)
-	client.emrconn = emr.New(sess)
-	client.esconn = elasticsearch.New(awsEsSess)
-	client.firehoseconn = firehose.New(sess)
-	client.fmsconn = fms.New(sess)
-	client.inspectorconn = inspector.New(sess)
-	client.gameliftconn = gamelift.New(sess)
-	client.glacierconn = glacier.New(sess)
-	client.guarddutyconn = guardduty.New(sess)
-	client.iotconn = iot.New(sess)
-	client.kinesisconn = kinesis.New(awsKinesisSess)
-	client.kinesisanalyticsconn = kinesisanalytics.New(awsKinesisAnalyticsSess)
-	client.kmsconn = kms.New(awsKmsSess)
-	client.lambdaconn = lambda.New(awsLambdaSess)
-	client.lexmodelconn = lexmodelbuildingservice.New(sess)
-	client.lightsailconn = lightsail.New(sess)
-	client.macieconn = macie.New(sess)
-	client.mqconn = mq.New(sess)
-	client.neptuneconn = neptune.New(sess)
-	client.opsworksconn = opsworks.New(sess)
-	client.organizationsconn = organizations.New(sess)
+	client.budgetconn = budgets.New(c.endpointSession(sess, "budgets"))
+	client.acmconn = acm.New(c.endpointSession(sess, "acm"))
+	client.acmpcaconn = acmpca.New(c.endpointSession(sess, "acmpca"))
+	client.apigateway = apigateway.New(c.endpointSession(sess, "apigateway"))
+	client.appautoscalingconn = applicationautoscaling.New(c.endpointSession(sess, "applicationautoscaling"))
+	client.autoscalingconn = autoscaling.New(c.endpointSession(sess, "autoscaling"))
+	client.cloud9conn = cloud9.New(c.endpointSession(sess, "cloud9"))
+	client.cfconn = cloudformation.New(c.endpointSession(sess, "cloudformation"))
+	client.cloudfrontconn = cloudfront.New(c.endpointSession(sess, "cloudfront"))
+	client.cloudhsmv2conn = cloudhsmv2.New(c.endpointSession(sess, "cloudhsm"))
+	client.cloudtrailconn = cloudtrail.New(c.endpointSession(sess, "cloudtrail"))
+	client.cloudwatchconn = cloudwatch.New(c.endpointSession(sess, "cloudwatch"))
+	client.cloudwatcheventsconn = cloudwatchevents.New(c.endpointSession(sess, "cloudwatchevents"))
+	client.cloudwatchlogsconn = cloudwatchlogs.New(c.endpointSession(sess, "cloudwatchlogs"))
+	client.codecommitconn = codecommit.New(c.endpointSession(sess, "codecommit"))
+	client.codebuildconn = codebuild.New(c.endpointSession(sess, "codebuild"))
+	client.codedeployconn = codedeploy.New(c.endpointSession(sess, "codedeploy"))
+	client.configconn = configservice.New(c.endpointSession(sess, "configservice"))
+	client.cognitoconn = cognitoidentity.New(c.endpointSession(sess, "cognitoidentity"))
+	client.cognitoidpconn = cognitoidentityprovider.New(c.endpointSession(sess, "cognitoidp"))
+	client.codepipelineconn = codepipeline.New(c.endpointSession(sess, "codepipeline"))
+	client.daxconn = dax.New(c.endpointSession(sess, "dax"))
+	client.dlmconn = dlm.New(c.endpointSession(sess, "dlm"))
+	client.dmsconn = databasemigrationservice.New(c.endpointSession(sess, "dms"))
+	client.dsconn = directoryservice.New(c.endpointSession(sess, "ds"))
+	client.dynamodbconn = dynamodb.New(c.endpointSession(sess, "dynamodb"))
+	client.ecrconn = ecr.New(c.endpointSession(sess, "ecr"))
+	client.ecsconn = ecs.New(c.endpointSession(sess, "ecs"))
+	client.efsconn = efs.New(c.endpointSession(sess, "efs"))
+	client.eksconn = eks.New(c.endpointSession(sess, "eks"))
+	client.elasticacheconn = elasticache.New(c.endpointSession(sess, "elasticache"))
+	client.elasticbeanstalkconn = elasticbeanstalk.New(c.endpointSession(sess, "elasticbeanstalk"))
+	client.elastictranscoderconn = elastictranscoder.New(c.endpointSession(sess, "elastictranscoder"))
+	client.elbconn = elb.New(c.endpointSession(sess, "elb"))
+	client.elbv2conn = elbv2.New(c.endpointSession(sess, "elbv2"))
+	client.emrconn = emr.New(c.endpointSession(sess, "emr"))
+	client.esconn = elasticsearch.New(c.endpointSession(sess, "es"))
+	client.firehoseconn = firehose.New(c.endpointSession(sess, "firehose"))
+	client.fmsconn = fms.New(c.endpointSession(sess, "fms"))
+	client.inspectorconn = inspector.New(c.endpointSession(sess, "inspector"))
+	client.gameliftconn = gamelift.New(c.endpointSession(sess, "gamelift"))
+	client.glacierconn = glacier.New(c.endpointSession(sess, "glacier"))
+	client.guarddutyconn = guardduty.New(c.endpointSession(sess, "guardduty"))
+	client.iotconn = iot.New(c.endpointSession(sess, "iot"))
+	client.kinesisconn = kinesis.New(c.endpointSession(sess, "kinesis"))
+	client.kinesisanalyticsconn = kinesisanalytics.New(c.endpointSession(sess, "kinesis_analytics"))
+	client.kmsconn = kms.New(c.endpointSession(sess, "kms"))
+	client.lambdaconn = lambda.New(c.endpointSession(sess, "lambda"))
+	client.lexmodelconn = lexmodelbuildingservice.New(c.endpointSession(sess, "lexmodels"))
+	client.lightsailconn = lightsail.New(c.endpointSession(sess, "lightsail"))
+	client.macieconn = macie.New(c.endpointSession(sess, "macie"))
+	client.mqconn = mq.New(c.endpointSession(sess, "mq"))
+	client.neptuneconn = neptune.New(c.endpointSession(sess, "neptune"))
+	client.opsworkssess = c.endpointSession(sess, "opsworks")
+	client.opsworksconn = opsworks.New(client.opsworkssess)
+	client.organizationsconn = organizations.New(c.endpointSession(sess, "organizations"))
 	client.r53conn = route53.New(r53Sess)
-	client.rdsconn = rds.New(awsRdsSess)
-	client.redshiftconn = redshift.New(sess)
-	client.simpledbconn = simpledb.New(sess)
-	client.s3conn = s3.New(awsS3Sess)
-	client.scconn = servicecatalog.New(sess)
-	client.sdconn = servicediscovery.New(sess)
-	client.sesConn = ses.New(sess)
-	client.secretsmanagerconn = secretsmanager.New(sess)
-	client.sfnconn = sfn.New(sess)
-	client.snsconn = sns.New(awsSnsSess)
-	client.sqsconn = sqs.New(awsSqsSess)
-	client.ssmconn = ssm.New(awsSsmSess)
-	client.storagegatewayconn = storagegateway.New(sess)
-	client.swfconn = swf.New(sess)
-	client.wafconn = waf.New(sess)
-	client.wafregionalconn = wafregional.New(sess)
-	client.batchconn = batch.New(sess)
-	client.glueconn = glue.New(sess)
-	client.athenaconn = athena.New(sess)
-	client.dxconn = directconnect.New(sess)
-	client.mediastoreconn = mediastore.New(sess)
-	client.appsyncconn = appsync.New(sess)
-	client.neptuneconn = neptune.New(sess)
-	client.pricingconn = pricing.New(sess)
-	client.pinpointconn = pinpoint.New(sess)
-	client.workspacesconn = workspaces.New(sess)
+	client.rdsconn = rds.New(c.endpointSession(sess, "rds"))
+	client.redshiftconn = redshift.New(c.endpointSession(sess, "redshift"))
+	client.simpledbconn = simpledb.New(c.endpointSession(sess, "sdb"))
+	client.s3conn = s3.New(c.endpointSession(sess, "s3"))
+	client.scconn = servicecatalog.New(c.endpointSession(sess, "servicecatalog"))
+	client.sdconn = servicediscovery.New(c.endpointSession(sess, "servicediscovery"))
+	client.sesConn = ses.New(c.endpointSession(sess, "ses"))
+	client.secretsmanagerconn = secretsmanager.New(c.endpointSession(sess, "secretsmanager"))
+	client.sfnconn = sfn.New(c.endpointSession(sess, "sfn"))
+	client.snsconn = sns.New(c.endpointSession(sess, "sns"))
+	client.sqsconn = sqs.New(c.endpointSession(sess, "sqs"))
+	client.ssmconn = ssm.New(c.endpointSession(sess, "ssm"))
+	client.storagegatewayconn = storagegateway.New(c.endpointSession(sess, "storagegateway"))
+	client.swfconn = swf.New(c.endpointSession(sess, "swf"))
+	client.wafconn = waf.New(c.endpointSession(sess, "waf"))
+	client.wafregionalconn = wafregional.New(c.endpointSession(sess, "wafregional"))
+	client.batchconn = batch.New(c.endpointSession(sess, "batch"))
+	client.glueconn = glue.New(c.endpointSession(sess, "glue"))
+	client.athenaconn = athena.New(c.endpointSession(sess, "athena"))
+	client.dxconn = directconnect.New(c.endpointSession(sess, "directconnect"))
+	client.mediastoreconn = mediastore.New(c.endpointSession(sess, "mediastore"))
+	client.appsyncconn = appsync.New(c.endpointSession(sess, "appsync"))
+	client.pricingconn = pricing.New(c.endpointSession(sess, "pricing"))
+	client.pinpointconn = pinpoint.New(c.endpointSession(sess, "pinpoint"))
+	client.workspacesconn = workspaces.New(c.endpointSession(sess, "workspaces"))
 
 	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
 	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
@@ -642,6 +666,127 @@ func (c *Config) Client() (interface{}, error) {
 	return &client, nil
 }
 
+// httpClient returns the HTTP client for AWS API requests, using the
+// configured proxy and TLS settings.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing http_proxy (%s): %s", c.HTTPProxy, err)
+		}
+		log.Printf("[INFO] Using HTTP proxy %s", proxyURL.Host)
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if (c.ClientCertificate == "") != (c.ClientPrivateKey == "") {
+		return nil, errors.New("client_certificate and client_private_key must be set together")
+	}
+
+	if !c.Insecure && c.CustomCABundle == "" && c.ClientCertificate == "" {
+		return client, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.CustomCABundle != "" {
+		caBundle, err := loadPEMContent(c.CustomCABundle)
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom_ca_bundle: %s", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			log.Printf("[WARN] Unable to load system certificate pool, only trusting custom_ca_bundle: %s", err)
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, errors.New("custom_ca_bundle does not contain any PEM encoded certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.ClientCertificate != "" {
+		certificate, err := loadPEMContent(c.ClientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client_certificate: %s", err)
+		}
+		privateKey, err := loadPEMContent(c.ClientPrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client_private_key: %s", err)
+		}
+
+		keyPair, err := tls.X509KeyPair(certificate, privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return client, nil
+}
+
+// loadPEMContent returns v if it is PEM encoded, otherwise the content of the
+// file at path v.
+func loadPEMContent(v string) ([]byte, error) {
+	if strings.Contains(v, "-----BEGIN ") {
+		return []byte(v), nil
+	}
+
+	return loadFileContent(v)
+}
+
+// assumedRoleARN returns the ARN of the role whose credentials are used by
+// the service clients, which is the last role of the chain, if any.
+func (c *Config) assumedRoleARN() string {
+	if n := len(c.AssumeRoleChainARNs); n > 0 {
+		return c.AssumeRoleChainARNs[n-1]
+	}
+	if c.AssumeRoleARN != "" {
+		return c.AssumeRoleARN
+	}
+	return c.AssumeRoleWithWebIdentityARN
+}
+
+// endpointSession returns a copy of the session that uses the custom endpoint
+// configured for the service, if any. Otherwise the endpoint is constructed
+// from the region as usual.
+func (c *Config) endpointSession(sess *session.Session, service string) *session.Session {
+	endpoint := c.Endpoints[service]
+
+	// The ELBv2 and DAX clients have historically used the ELB and DynamoDB
+	// endpoints respectively.
+	if endpoint == "" {
+		switch service {
+		case "dax":
+			endpoint = c.Endpoints["dynamodb"]
+		case "elbv2":
+			endpoint = c.Endpoints["elb"]
+		}
+	}
+
+	s := sess.Copy(&aws.Config{Endpoint: aws.String(endpoint)})
+	c.addRateLimitHandler(s, service)
+
+	return s
+}
+
+// addRateLimitHandler installs a token bucket on the session when a rate
+// limit is configured for the service. The bucket is waited for while signing
+// every attempt, as the request is not sent when signing fails.
+func (c *Config) addRateLimitHandler(sess *session.Session, service string) {
+	if limit, ok := c.RateLimits[service]; ok {
+		log.Printf("[INFO] Limiting %s requests to %g per second (burst: %d)", service, limit.RequestsPerSecond, limit.Burst)
+		sess.Handlers.Sign.PushFrontNamed(newRateLimiter(service, limit).handler())
+	}
+}
+
 func hasEc2Classic(platforms []string) bool {
 	for _, p := range platforms {
 		if p == "EC2" {
@@ -750,5 +895,5 @@ func (l awsLogger) Log(args ...interface{}) {
 			tokens = append(tokens, token)
 		}
 	}
-	log.Printf("[DEBUG] [aws-sdk-go] %s", strings.Join(tokens, " "))
+	log.Printf("[DEBUG] [aws-sdk-go] %s", redactLogMessage(strings.Join(tokens, " ")))
 }
@@ -2,10 +2,18 @@ package aws
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
 	"log"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"reflect"
 	"testing"
 	"time"
@@ -44,7 +52,24 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 // getMockedAwsApiSession establishes a httptest server to simulate behaviour
 // of a real AWS API server
 func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(), *session.Session, error) {
-	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	ts := newMockedAwsApiServer(svcName, endpoints)
+
+	sc := awsCredentials.NewStaticCredentials("accessKey", "secretKey", "")
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:                   sc,
+		Region:                        aws.String("us-east-1"),
+		Endpoint:                      aws.String(ts.URL),
+		CredentialsChainVerboseErrors: aws.Bool(true),
+	})
+
+	return ts.Close, sess, err
+}
+
+// newMockedAwsApiServer returns a server responding to the mocked requests
+// and with a 400 status code to any other request.
+func newMockedAwsApiServer(svcName string, endpoints []*awsMockEndpoint) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		buf := new(bytes.Buffer)
 		buf.ReadFrom(r.Body)
 		requestBody := buf.String()
@@ -70,17 +95,6 @@ func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(
 		w.WriteHeader(400)
 		return
 	}))
-
-	sc := awsCredentials.NewStaticCredentials("accessKey", "secretKey", "")
-
-	sess, err := session.NewSession(&aws.Config{
-		Credentials:                   sc,
-		Region:                        aws.String("us-east-1"),
-		Endpoint:                      aws.String(ts.URL),
-		CredentialsChainVerboseErrors: aws.Bool(true),
-	})
-
-	return ts.Close, sess, err
 }
 
 type awsMockEndpoint struct {
@@ -116,3 +130,209 @@ var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesRes
     </item>
   </accountAttributeSet>
 </DescribeAccountAttributesResponse>`
+
+func TestConfigEndpointSession(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: awsCredentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-east-1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Config{
+		Endpoints: map[string]string{
+			"dynamodb": "http://localhost:8000",
+			"elb":      "http://localhost:5000",
+			"elbv2":    "http://localhost:5001",
+			"glue":     "http://localhost:5002",
+		},
+	}
+
+	cases := []struct {
+		Service  string
+		Expected string
+	}{
+		{Service: "glue", Expected: "http://localhost:5002"},
+		{Service: "sfn", Expected: ""},
+		{Service: "dax", Expected: "http://localhost:8000"},
+		{Service: "elbv2", Expected: "http://localhost:5001"},
+	}
+
+	for _, tc := range cases {
+		endpoint := aws.StringValue(c.endpointSession(sess, tc.Service).Config.Endpoint)
+		if endpoint != tc.Expected {
+			t.Fatalf("%s: expected endpoint %q, got %q", tc.Service, tc.Expected, endpoint)
+		}
+	}
+}
+
+func TestConfigHTTPClient_customCABundle(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+	}))
+	defer ts.Close()
+
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+
+	client, err := (&Config{}).httpClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(ts.URL); err == nil {
+		t.Fatal("Expected an unknown authority error without custom_ca_bundle, got none")
+	}
+
+	client, err = (&Config{CustomCABundle: caBundle}).httpClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(ts.URL); err != nil {
+		t.Fatalf("Expected the server to be trusted with custom_ca_bundle, got: %s", err)
+	}
+
+	if _, err := (&Config{CustomCABundle: "-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----"}).httpClient(); err == nil {
+		t.Fatal("Expected an error for a custom_ca_bundle without certificates, got none")
+	}
+}
+
+func TestConfigClient_customCABundleEnv(t *testing.T) {
+	systemPool, err := x509.SystemCertPool()
+	if err != nil {
+		t.Skipf("Unable to load system certificate pool: %s", err)
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+	}))
+	defer ts.Close()
+
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+	os.Setenv("AWS_CA_BUNDLE", caBundle)
+	defer os.Unsetenv("AWS_CA_BUNDLE")
+
+	c := &Config{
+		AccessKey:               "accessKey",
+		SecretKey:               "secretKey",
+		Region:                  "us-east-1",
+		CustomCABundle:          caBundle,
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipRegionValidation:    true,
+		SkipRequestingAccountId: true,
+		SkipMetadataApiCheck:    true,
+	}
+	client, err := c.Client()
+	if err != nil {
+		t.Fatalf("Expected no error with an inline PEM AWS_CA_BUNDLE, got: %s", err)
+	}
+
+	if v := os.Getenv("AWS_CA_BUNDLE"); v != caBundle {
+		t.Fatalf("Expected AWS_CA_BUNDLE to be restored, got: %q", v)
+	}
+
+	if !systemPool.AppendCertsFromPEM([]byte(caBundle)) {
+		t.Fatal("Unable to append the custom CA bundle to the system certificate pool")
+	}
+	transport := client.(*AWSClient).s3conn.Config.HTTPClient.Transport.(*http.Transport)
+	if !transport.TLSClientConfig.RootCAs.Equal(systemPool) {
+		t.Fatal("Expected the system certificates and the custom CA bundle to be trusted")
+	}
+}
+
+func TestConfigHTTPClient_httpProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(200)
+	}))
+	defer proxy.Close()
+
+	client, err := (&Config{HTTPProxy: proxy.URL}).httpClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get("http://ec2.us-east-1.amazonaws.com/"); err != nil {
+		t.Fatalf("Error sending request through the proxy: %s", err)
+	}
+	if proxied != "http://ec2.us-east-1.amazonaws.com/" {
+		t.Fatalf("Expected the request to go through the proxy, got %q", proxied)
+	}
+}
+
+func TestConfigHTTPClient_clientCertificate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+
+	client, err := (&Config{ClientCertificate: certificate, ClientPrivateKey: privateKey}).httpClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlsConfig := client.Transport.(*http.Transport).TLSClientConfig
+	if tlsConfig == nil || len(tlsConfig.Certificates) != 1 {
+		t.Fatal("Expected the client certificate in the TLS configuration")
+	}
+
+	if _, err := (&Config{ClientCertificate: certificate}).httpClient(); err == nil {
+		t.Fatal("Expected an error for client_certificate without client_private_key, got none")
+	}
+}
+
+func TestConfigAssumedRoleARN(t *testing.T) {
+	cases := []struct {
+		Config   *Config
+		Expected string
+	}{
+		{
+			Config:   &Config{},
+			Expected: "",
+		},
+		{
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN: "arn:aws:iam::111111111111:role/web-identity",
+			},
+			Expected: "arn:aws:iam::111111111111:role/web-identity",
+		},
+		{
+			Config: &Config{
+				AssumeRoleARN:                "arn:aws:iam::222222222222:role/first",
+				AssumeRoleWithWebIdentityARN: "arn:aws:iam::111111111111:role/web-identity",
+			},
+			Expected: "arn:aws:iam::222222222222:role/first",
+		},
+		{
+			Config: &Config{
+				AssumeRoleARN: "arn:aws:iam::222222222222:role/first",
+				AssumeRoleChainARNs: []string{
+					"arn:aws:iam::333333333333:role/second",
+					"arn:aws:iam::444444444444:role/third",
+				},
+			},
+			Expected: "arn:aws:iam::444444444444:role/third",
+		},
+	}
+
+	for i, tc := range cases {
+		if got := tc.Config.assumedRoleARN(); got != tc.Expected {
+			t.Fatalf("%d: expected %q, got %q", i, tc.Expected, got)
+		}
+	}
+}
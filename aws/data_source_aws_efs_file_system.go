@@ -105,7 +105,7 @@ func dataSourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	err = d.Set("tags", tagsToMapEFS(tags))
+	err = d.Set("tags", tagsToMapEFS(tags, meta.(*AWSClient).ignoreTagsConfig))
 	if err != nil {
 		return err
 	}
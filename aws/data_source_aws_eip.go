@@ -80,7 +80,7 @@ func dataSourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("public_ip", eip.PublicIp)
-	d.Set("tags", tagsToMap(eip.Tags))
+	d.Set("tags", tagsToMap(eip.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
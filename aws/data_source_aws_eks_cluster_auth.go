@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	// eksClusterAuthTokenPrefix is the version prefix of the bearer tokens
+	// accepted by the AWS IAM Authenticator of EKS clusters.
+	eksClusterAuthTokenPrefix = "k8s-aws-v1."
+
+	// eksClusterAuthClusterIdHeader is the header binding the presigned
+	// request, and so the token, to a single cluster.
+	eksClusterAuthClusterIdHeader = "x-k8s-aws-id"
+
+	// eksClusterAuthPresignExpiration is the expiration of the presigned
+	// request. The authenticator itself only accepts tokens for 15 minutes.
+	eksClusterAuthPresignExpiration = 60 * time.Second
+)
+
+func dataSourceAwsEksClusterAuth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEksClusterAuthRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEksClusterAuthRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).stsconn
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Generating EKS Cluster (%s) authentication token", name)
+	token, err := generateEksClusterAuthToken(conn, name)
+	if err != nil {
+		return fmt.Errorf("error generating EKS Cluster (%s) authentication token: %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("token", token)
+
+	return nil
+}
+
+// generateEksClusterAuthToken returns a bearer token for the Kubernetes API
+// server of the cluster, the base64 encoded URL of an STS GetCallerIdentity
+// request presigned with the provider credentials.
+func generateEksClusterAuthToken(conn *sts.STS, clusterName string) (string, error) {
+	req, _ := conn.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+	req.HTTPRequest.Header.Add(eksClusterAuthClusterIdHeader, clusterName)
+
+	presignedURL, err := req.Presign(eksClusterAuthPresignExpiration)
+	if err != nil {
+		return "", err
+	}
+
+	return eksClusterAuthTokenPrefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURL)), nil
+}
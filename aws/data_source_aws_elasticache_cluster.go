@@ -234,7 +234,7 @@ func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{
 	if len(tagResp.TagList) > 0 {
 		et = tagResp.TagList
 	}
-	d.Set("tags", tagsToMapEC(et))
+	d.Set("tags", tagsToMapEC(et, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 
@@ -76,7 +76,7 @@ func dataSourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{})
 
 	igw := resp.InternetGateways[0]
 	d.SetId(aws.StringValue(igw.InternetGatewayId))
-	d.Set("tags", tagsToMap(igw.Tags))
+	d.Set("tags", tagsToMap(igw.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	d.Set("internet_gateway_id", igw.InternetGatewayId)
 	if err := d.Set("attachments", dataSourceAttachmentsRead(igw.Attachments)); err != nil {
 		return err
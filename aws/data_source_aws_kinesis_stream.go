@@ -89,7 +89,7 @@ func dataSourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tagsToMapKinesis(tags.Tags))
+	d.Set("tags", tagsToMapKinesis(tags.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
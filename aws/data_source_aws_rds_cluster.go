@@ -259,7 +259,7 @@ func dataSourceAwsRdsClusterRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Fetch and save tags
-	if err := saveTagsRDS(conn, d, aws.StringValue(dbc.DBClusterArn)); err != nil {
+	if err := saveTagsRDS(conn, d, aws.StringValue(dbc.DBClusterArn), meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		log.Printf("[WARN] Failed to save tags for RDS Cluster (%s): %s", aws.StringValue(dbc.DBClusterIdentifier), err)
 	}
 
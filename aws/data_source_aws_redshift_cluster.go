@@ -248,7 +248,7 @@ func dataSourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{})
 	d.Set("port", rsc.Endpoint.Port)
 	d.Set("preferred_maintenance_window", rsc.PreferredMaintenanceWindow)
 	d.Set("publicly_accessible", rsc.PubliclyAccessible)
-	d.Set("tags", tagsToMapRedshift(rsc.Tags))
+	d.Set("tags", tagsToMapRedshift(rsc.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	d.Set("vpc_id", rsc.VpcId)
 
 	var vpcg []string
@@ -171,7 +171,7 @@ func dataSourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("instance_tenancy", vpc.InstanceTenancy)
 	d.Set("default", vpc.IsDefault)
 	d.Set("state", vpc.State)
-	d.Set("tags", tagsToMap(vpc.Tags))
+	d.Set("tags", tagsToMap(vpc.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	arn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
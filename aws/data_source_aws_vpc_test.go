@@ -82,6 +82,26 @@ func TestAccDataSourceAwsVpc_multipleCidr(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAwsVpc_ignoreTags(t *testing.T) {
+	rInt := rand.Intn(16)
+	dataSourceName := "data.aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcConfigIgnoreTags(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", "terraform-testacc-vpc-data-source-ignore-tags"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceAwsVpcCheck(name, cidr, tag string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -190,3 +210,31 @@ data "aws_vpc" "test" {
 }
 `, octet, octet)
 }
+
+func testAccDataSourceAwsVpcConfigIgnoreTags(octet int) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    keys = ["LastScanned"]
+  }
+}
+
+provider "aws" {
+  alias = "unfiltered"
+}
+
+resource "aws_vpc" "test" {
+  provider   = "aws.unfiltered"
+  cidr_block = "10.%d.0.0/16"
+
+  tags {
+    Name        = "terraform-testacc-vpc-data-source-ignore-tags"
+    LastScanned = "2018-11-12"
+  }
+}
+
+data "aws_vpc" "test" {
+  id = "${aws_vpc.test.id}"
+}
+`, octet)
+}
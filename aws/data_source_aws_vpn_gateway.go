@@ -105,7 +105,7 @@ func dataSourceAwsVpnGatewayRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("state", vgw.State)
 	d.Set("availability_zone", vgw.AvailabilityZone)
 	d.Set("amazon_side_asn", strconv.FormatInt(aws.Int64Value(vgw.AmazonSideAsn), 10))
-	d.Set("tags", tagsToMap(vgw.Tags))
+	d.Set("tags", tagsToMap(vgw.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	for _, attachment := range vgw.VpcAttachments {
 		if *attachment.State == "attached" {
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -18,7 +21,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -43,6 +46,8 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -75,6 +80,120 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["retry"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      RetryModeLegacy,
+							Description:  descriptions["retry_mode"],
+							ValidateFunc: validation.StringInSlice(retryModes(), false),
+						},
+
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  descriptions["retry_max_attempts"],
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  descriptions["retry_max_backoff"],
+							ValidateFunc: validateDuration,
+						},
+
+						"base_delay": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  descriptions["retry_base_delay"],
+							ValidateFunc: validateDuration,
+						},
+
+						"retryable_error_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: descriptions["retry_retryable_error_codes"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+						},
+					},
+				},
+			},
+
+			"rate_limits": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: descriptions["rate_limits"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  descriptions["rate_limits_service"],
+							ValidateFunc: validation.StringInSlice(endpointServiceNames, false),
+						},
+
+						"requests_per_second": {
+							Type:        schema.TypeFloat,
+							Required:    true,
+							Description: descriptions["rate_limits_requests_per_second"],
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								if v.(float64) <= 0 {
+									errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+								}
+								return
+							},
+						},
+
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  descriptions["rate_limits_burst"],
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"audit_log": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["audit_log"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions["audit_log_path"],
+						},
+
+						"mutating_only": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: descriptions["audit_log_mutating_only"],
+						},
+
+						"redacted_fields": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: descriptions["audit_log_redacted_fields"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+						},
+					},
+				},
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -92,19 +211,59 @@ func Provider() terraform.ResourceProvider {
 			},
 
 			"dynamodb_endpoint": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: descriptions["dynamodb_endpoint"],
-				Removed:     "Use `dynamodb` inside `endpoints` block instead",
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Removed:  "Use `dynamodb` inside `endpoints` block instead",
 			},
 
 			"kinesis_endpoint": {
-				Type:        schema.TypeString,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Removed:  "Use `kinesis` inside `endpoints` block instead",
+			},
+
+			"default_tags": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Default:     "",
-				Description: descriptions["kinesis_endpoint"],
-				Removed:     "Use `kinesis` inside `endpoints` block instead",
+				MaxItems:    1,
+				Description: descriptions["default_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions["default_tags_tags"],
+						},
+					},
+				},
+			},
+
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["ignore_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: descriptions["ignore_tags_keys"],
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: descriptions["ignore_tags_key_prefixes"],
+						},
+					},
+				},
 			},
 
 			"endpoints": endpointsSchema(),
@@ -116,6 +275,32 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["insecure"],
 			},
 
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["http_proxy"],
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["client_certificate"],
+			},
+
+			"client_private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["client_private_key"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -200,6 +385,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_efs_mount_target":                 dataSourceAwsEfsMountTarget(),
 			"aws_eip":                              dataSourceAwsEip(),
 			"aws_eks_cluster":                      dataSourceAwsEksCluster(),
+			"aws_eks_cluster_auth":                 dataSourceAwsEksClusterAuth(),
 			"aws_elastic_beanstalk_hosted_zone":    dataSourceAwsElasticBeanstalkHostedZone(),
 			"aws_elastic_beanstalk_solution_stack": dataSourceAwsElasticBeanstalkSolutionStack(),
 			"aws_elasticache_cluster":              dataSourceAwsElastiCacheCluster(),
@@ -699,6 +885,14 @@ func Provider() terraform.ResourceProvider {
 			"aws_pinpoint_gcm_channel":                         resourceAwsPinpointGCMChannel(),
 			"aws_pinpoint_sms_channel":                         resourceAwsPinpointSMSChannel(),
 
+			"aws_s3_bucket_cors_configuration":                   resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":              resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                              resourceAwsS3BucketLogging(),
+			"aws_s3_bucket_replication_configuration":            resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration": resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                           resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                resourceAwsS3BucketWebsiteConfiguration(),
+
 			// ALBs are actually LBs because they can be type `network` or `application`
 			// To avoid regressions, we will add a new resource for each and they both point
 			// back to the old ALB version. IF the Terraform supported aliases for resources
@@ -718,6 +912,25 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	// Every resource with a user-configurable tags map also exposes the
+	// tags_all attribute, which includes the provider default_tags, unless
+	// its tags cannot be read back from the API.
+	for name, r := range provider.ResourcesMap {
+		if resourcesWithoutDefaultTags[name] {
+			continue
+		}
+		resourceWithDefaultTags(r)
+	}
+
+	return provider
+}
+
+// resourcesWithoutDefaultTags lists the resources whose tags are only sent on
+// creation and never read back, so provider default_tags would cause a
+// perpetual diff.
+var resourcesWithoutDefaultTags = map[string]bool{
+	"aws_elasticache_replication_group": true,
 }
 
 var descriptions map[string]string
@@ -746,54 +959,52 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
-		"apigateway_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"retry": "Configuration block with the retry policy of AWS API requests.",
 
-		"cloudformation_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"retry_mode": "The retry mode, either `legacy` for the AWS SDK default backoff\n" +
+			"or `standard` for exponential backoff with full jitter.",
 
-		"cloudwatch_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"retry_max_attempts": "The maximum number of attempts of an AWS API request,\n" +
+			"overriding `max_retries`.",
 
-		"cloudwatchevents_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"retry_max_backoff": "The maximum delay between two attempts, e.g. `20s`.",
 
-		"cloudwatchlogs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"retry_base_delay": "The delay before the first retry in `standard` mode, e.g. `1s`.",
 
-		"devicefarm_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"retry_retryable_error_codes": "Additional AWS error codes to retry.",
 
-		"dynamodb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
-			"It's typically used to connect to dynamodb-local.",
+		"rate_limits": "Client-side rate limits of the AWS API requests, one block per service.",
 
-		"kinesis_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
-			"It's typically used to connect to kinesalite.",
+		"rate_limits_service": "The service to rate limit, one of the `endpoints` block arguments.",
 
-		"kinesis_analytics_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"rate_limits_requests_per_second": "The sustained number of requests per second to the service.",
 
-		"kms_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"rate_limits_burst": "The number of requests that can be sent at once before being rate limited.",
 
-		"iam_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"audit_log": "Configuration block to log every AWS API request as a JSON document to a file.",
 
-		"lambda_endpoint": "Use this to override the default endpoint URL constructed from the `region`\n",
+		"audit_log_path": "The path of the audit log file. Entries are appended to an existing file.",
 
-		"ec2_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"audit_log_mutating_only": "Only log the requests of operations that may change resources.",
 
-		"autoscaling_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"audit_log_redacted_fields": "Additional names of request parameters to redact from the audit log.",
 
-		"efs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"endpoint": "Use this to override the default service endpoint URL constructed from the `region`.\n",
 
-		"elb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"es_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"rds_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"s3_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
+			"default value is `false`",
 
-		"sns_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"http_proxy": "The URL of the proxy for AWS API requests, e.g. `http://proxy.example.com:3128`.\n" +
+			"If omitted, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used.",
 
-		"sqs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"custom_ca_bundle": "The path to a file, or the content, of PEM encoded certificates trusted\n" +
+			"in addition to the system certificates when verifying AWS API endpoints.",
 
-		"ssm_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"client_certificate": "The path to a file, or the content, of the PEM encoded client certificate\n" +
+			"presented to the proxy or the AWS API endpoints.",
 
-		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
-			"default value is `false`",
+		"client_private_key": "The path to a file, or the content, of the PEM encoded private key\n" +
+			"of the client certificate.",
 
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
@@ -815,6 +1026,17 @@ func init() {
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 
+		"default_tags": "Configuration block with settings to default resource tags across all resources.",
+
+		"default_tags_tags": "Resource tags to default across all resources. Tags configured on a\n" +
+			"resource take precedence over these.",
+
+		"ignore_tags": "Configuration block with settings to ignore resource tags across all resources.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
+
 		"assume_role_role_arn": "The ARN of an IAM role to assume prior to making API calls.",
 
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted," +
@@ -826,6 +1048,30 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session. If omitted," +
+			" the AWS SDK default of 15 minutes is used. Chained role sessions are limited to one hour.",
+
+		"assume_role_tags": "Session tags to pass when assuming the role.",
+
+		"assume_role_transitive_tag_keys": "Session tag keys to pass to subsequent sessions in a role chain.",
+
+		"assume_role_chained_role_arns": "The ARNs of IAM roles to assume in order after role_arn," +
+			" each with the credentials of the previous role.",
+
+		"assume_role_with_web_identity": "Configuration block to assume an IAM role with a web identity" +
+			" (OpenID Connect) token before making API calls.",
+
+		"assume_role_with_web_identity_role_arn": "The ARN of an IAM role to assume with the web identity token.",
+
+		"assume_role_with_web_identity_session_name": "The session name to use when assuming the role." +
+			" If omitted, a timestamp is used.",
+
+		"assume_role_with_web_identity_web_identity_token_file": "The path to a file containing the web identity" +
+			" token. The file is read each time the credentials are refreshed.",
+
+		"assume_role_with_web_identity_duration_seconds": "The duration, in seconds, of the role session. If omitted," +
+			" the AWS default of one hour is used.",
 	}
 }
 
@@ -838,6 +1084,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Region:                  d.Get("region").(string),
 		MaxRetries:              d.Get("max_retries").(int),
 		Insecure:                d.Get("insecure").(bool),
+		HTTPProxy:               d.Get("http_proxy").(string),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
+		ClientCertificate:       d.Get("client_certificate").(string),
+		ClientPrivateKey:        d.Get("client_private_key").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -864,43 +1114,104 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			config.AssumeRolePolicy = v
 		}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
+		config.AssumeRoleDurationSeconds = assumeRole["duration_seconds"].(int)
+		config.AssumeRoleTags = aws.StringValueMap(stringMapToPointers(assumeRole["tags"].(map[string]interface{})))
+		config.AssumeRoleTransitiveTagKeys = aws.StringValueSlice(expandStringSet(assumeRole["transitive_tag_keys"].(*schema.Set)))
+		config.AssumeRoleChainARNs = aws.StringValueSlice(expandStringList(assumeRole["chained_role_arns"].([]interface{})))
+
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q, DurationSeconds: %d, ChainedRoleARNs: %q)",
+			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy,
+			config.AssumeRoleDurationSeconds, config.AssumeRoleChainARNs)
 	} else {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		assumeRoleWithWebIdentity := v.([]interface{})[0].(map[string]interface{})
+		config.AssumeRoleWithWebIdentityARN = assumeRoleWithWebIdentity["role_arn"].(string)
+		config.AssumeRoleWithWebIdentitySessionName = assumeRoleWithWebIdentity["session_name"].(string)
+		config.AssumeRoleWithWebIdentityDurationSeconds = assumeRoleWithWebIdentity["duration_seconds"].(int)
+
+		tokenFile, err := homedir.Expand(assumeRoleWithWebIdentity["web_identity_token_file"].(string))
+		if err != nil {
+			return nil, err
+		}
+		config.AssumeRoleWithWebIdentityTokenFile = tokenFile
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q, WebIdentityTokenFile: %q, DurationSeconds: %d)",
+			config.AssumeRoleWithWebIdentityARN, config.AssumeRoleWithWebIdentitySessionName,
+			config.AssumeRoleWithWebIdentityTokenFile, config.AssumeRoleWithWebIdentityDurationSeconds)
+	}
+
+	if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		retry := v.([]interface{})[0].(map[string]interface{})
+		config.RetryConfig = &RetryConfig{
+			Mode:                retry["mode"].(string),
+			MaxAttempts:         retry["max_attempts"].(int),
+			RetryableErrorCodes: aws.StringValueSlice(expandStringSet(retry["retryable_error_codes"].(*schema.Set))),
+		}
+		if v := retry["max_backoff"].(string); v != "" {
+			config.RetryConfig.MaxBackoff, err = time.ParseDuration(v)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if v := retry["base_delay"].(string); v != "" {
+			config.RetryConfig.BaseDelay, err = time.ParseDuration(v)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("rate_limits"); ok {
+		config.RateLimits = make(map[string]RateLimit)
+		for _, rateLimitI := range v.(*schema.Set).List() {
+			rateLimit := rateLimitI.(map[string]interface{})
+			config.RateLimits[rateLimit["service"].(string)] = RateLimit{
+				RequestsPerSecond: rateLimit["requests_per_second"].(float64),
+				Burst:             rateLimit["burst"].(int),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("audit_log"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		auditLog := v.([]interface{})[0].(map[string]interface{})
+
+		path, err := homedir.Expand(auditLog["path"].(string))
+		if err != nil {
+			return nil, err
+		}
+		config.AuditLogConfig = &AuditLogConfig{
+			Path:           path,
+			MutatingOnly:   auditLog["mutating_only"].(bool),
+			RedactedFields: aws.StringValueSlice(expandStringSet(auditLog["redacted_fields"].(*schema.Set))),
+		}
+	}
+
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		defaultTags := v.([]interface{})[0].(map[string]interface{})
+		config.DefaultTags = defaultTags["tags"].(map[string]interface{})
+	}
+
+	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		ignoreTags := v.([]interface{})[0].(map[string]interface{})
+		config.IgnoreTagsConfig = &IgnoreTagsConfig{
+			Keys:        aws.StringValueSlice(expandStringSet(ignoreTags["keys"].(*schema.Set))),
+			KeyPrefixes: aws.StringValueSlice(expandStringSet(ignoreTags["key_prefixes"].(*schema.Set))),
+		}
+
+		config.IgnoreTagsConfig.warnConfiguredTags(config.DefaultTags)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
+	config.Endpoints = make(map[string]string)
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.AcmEndpoint = endpoints["acm"].(string)
-		config.ApigatewayEndpoint = endpoints["apigateway"].(string)
-		config.CloudFormationEndpoint = endpoints["cloudformation"].(string)
-		config.CloudWatchEndpoint = endpoints["cloudwatch"].(string)
-		config.CloudWatchEventsEndpoint = endpoints["cloudwatchevents"].(string)
-		config.CloudWatchLogsEndpoint = endpoints["cloudwatchlogs"].(string)
-		config.DeviceFarmEndpoint = endpoints["devicefarm"].(string)
-		config.DynamoDBEndpoint = endpoints["dynamodb"].(string)
-		config.Ec2Endpoint = endpoints["ec2"].(string)
-		config.AutoscalingEndpoint = endpoints["autoscaling"].(string)
-		config.EcrEndpoint = endpoints["ecr"].(string)
-		config.EcsEndpoint = endpoints["ecs"].(string)
-		config.EfsEndpoint = endpoints["efs"].(string)
-		config.ElbEndpoint = endpoints["elb"].(string)
-		config.EsEndpoint = endpoints["es"].(string)
-		config.IamEndpoint = endpoints["iam"].(string)
-		config.KinesisEndpoint = endpoints["kinesis"].(string)
-		config.KinesisAnalyticsEndpoint = endpoints["kinesis_analytics"].(string)
-		config.KmsEndpoint = endpoints["kms"].(string)
-		config.LambdaEndpoint = endpoints["lambda"].(string)
-		config.R53Endpoint = endpoints["r53"].(string)
-		config.RdsEndpoint = endpoints["rds"].(string)
-		config.S3Endpoint = endpoints["s3"].(string)
-		config.SnsEndpoint = endpoints["sns"].(string)
-		config.SqsEndpoint = endpoints["sqs"].(string)
-		config.StsEndpoint = endpoints["sts"].(string)
-		config.SsmEndpoint = endpoints["ssm"].(string)
+		for _, endpointServiceName := range endpointServiceNames {
+			config.Endpoints[endpointServiceName] = endpoints[endpointServiceName].(string)
+		}
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -947,187 +1258,189 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
-			},
-		},
-	}
-}
 
-func endpointsSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"acm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["acm_endpoint"],
-				},
-				"apigateway": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["apigateway_endpoint"],
-				},
-				"cloudwatch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatch_endpoint"],
-				},
-				"cloudwatchevents": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchevents_endpoint"],
-				},
-				"cloudwatchlogs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchlogs_endpoint"],
-				},
-				"cloudformation": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudformation_endpoint"],
-				},
-				"devicefarm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["devicefarm_endpoint"],
-				},
-				"dynamodb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["dynamodb_endpoint"],
-				},
-				"iam": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["iam_endpoint"],
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["assume_role_duration_seconds"],
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
 
-				"ec2": {
-					Type:        schema.TypeString,
+				"tags": {
+					Type:        schema.TypeMap,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["ec2_endpoint"],
+					Description: descriptions["assume_role_tags"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
 				},
 
-				"autoscaling": {
-					Type:        schema.TypeString,
+				"transitive_tag_keys": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["autoscaling_endpoint"],
+					Description: descriptions["assume_role_transitive_tag_keys"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
 				},
 
-				"ecr": {
-					Type:        schema.TypeString,
+				"chained_role_arns": {
+					Type:        schema.TypeList,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["ecr_endpoint"],
+					Description: descriptions["assume_role_chained_role_arns"],
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateArn,
+					},
 				},
+			},
+		},
+	}
+}
 
-				"ecs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ecs_endpoint"],
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["assume_role_with_web_identity"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  descriptions["assume_role_with_web_identity_role_arn"],
+					ValidateFunc: validateArn,
 				},
 
-				"efs": {
+				"session_name": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["efs_endpoint"],
+					Description: descriptions["assume_role_with_web_identity_session_name"],
 				},
 
-				"elb": {
+				"web_identity_token_file": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["elb_endpoint"],
+					Required:    true,
+					Description: descriptions["assume_role_with_web_identity_web_identity_token_file"],
 				},
-				"es": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["es_endpoint"],
-				},
-				"kinesis": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kinesis_endpoint"],
-				},
-				"kinesis_analytics": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kinesis_analytics_endpoint"],
-				},
-				"kms": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kms_endpoint"],
-				},
-				"lambda": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["lambda_endpoint"],
-				},
-				"r53": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["r53_endpoint"],
-				},
-				"rds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
-				},
-				"s3": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["s3_endpoint"],
-				},
-				"sns": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sns_endpoint"],
-				},
-				"sqs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sqs_endpoint"],
-				},
-				"sts": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sts_endpoint"],
-				},
-				"ssm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ssm_endpoint"],
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["assume_role_with_web_identity_duration_seconds"],
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
 			},
 		},
+	}
+}
+
+// endpointServiceNames is the list of services whose endpoints can be
+// overridden in the provider endpoints block, one for each service client.
+var endpointServiceNames = []string{
+	"acm",
+	"acmpca",
+	"apigateway",
+	"applicationautoscaling",
+	"appsync",
+	"athena",
+	"autoscaling",
+	"batch",
+	"budgets",
+	"cloud9",
+	"cloudformation",
+	"cloudfront",
+	"cloudhsm",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codebuild",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"cognitoidentity",
+	"cognitoidp",
+	"configservice",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"dlm",
+	"dms",
+	"ds",
+	"dynamodb",
+	"ec2",
+	"ecr",
+	"ecs",
+	"efs",
+	"eks",
+	"elasticache",
+	"elasticbeanstalk",
+	"elastictranscoder",
+	"elb",
+	"elbv2",
+	"emr",
+	"es",
+	"firehose",
+	"fms",
+	"gamelift",
+	"glacier",
+	"glue",
+	"guardduty",
+	"iam",
+	"inspector",
+	"iot",
+	"kinesis",
+	"kinesis_analytics",
+	"kms",
+	"lambda",
+	"lexmodels",
+	"lightsail",
+	"macie",
+	"mediastore",
+	"mq",
+	"neptune",
+	"opsworks",
+	"organizations",
+	"pinpoint",
+	"pricing",
+	"r53",
+	"rds",
+	"redshift",
+	"s3",
+	"sdb",
+	"secretsmanager",
+	"servicecatalog",
+	"servicediscovery",
+	"ses",
+	"sfn",
+	"sns",
+	"sqs",
+	"ssm",
+	"storagegateway",
+	"sts",
+	"swf",
+	"waf",
+	"wafregional",
+	"workspaces",
+}
+
+func endpointsSchema() *schema.Schema {
+	endpointsAttributes := make(map[string]*schema.Schema)
+
+	for _, endpointServiceName := range endpointServiceNames {
+		endpointsAttributes[endpointServiceName] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: descriptions["endpoint"],
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: endpointsAttributes,
+		},
 		Set: endpointsToHash,
 	}
 }
@@ -1135,25 +1448,9 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["apigateway"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatch"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchevents"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchlogs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudformation"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["devicefarm"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dynamodb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["iam"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ec2"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["autoscaling"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["efs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["elb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kinesis"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["lambda"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["s3"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sqs"].(string)))
+	for _, endpointServiceName := range endpointServiceNames {
+		buf.WriteString(fmt.Sprintf("%s-", m[endpointServiceName].(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
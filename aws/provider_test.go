@@ -5,8 +5,10 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/organizations"
 
@@ -27,6 +29,12 @@ var testAccProvider *schema.Provider
 var testAccTemplateProvider *schema.Provider
 
 func init() {
+	// The acceptance tests run the provider in the test process, so each run
+	// of the tests is a cassette recording of its own.
+	if os.Getenv(cassetteRecordingEnvVar) == "" {
+		os.Setenv(cassetteRecordingEnvVar, strconv.FormatInt(time.Now().UnixNano(), 10))
+	}
+
 	testAccProvider = Provider().(*schema.Provider)
 	testAccTemplateProvider = template.Provider().(*schema.Provider)
 	testAccProviders = map[string]terraform.ResourceProvider{
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// RateLimit is the client-side rate limit of the API requests to a service,
+// configured in the provider rate_limits block.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// rateLimiter is a token bucket holding up to burst tokens, refilled at
+// the configured number of requests per second. Every request sent,
+// including retries, takes one token.
+type rateLimiter struct {
+	service string
+	limit   RateLimit
+
+	mu       sync.Mutex
+	tokens   float64
+	last     time.Time
+	requests int64
+	delayed  int64
+	waited   time.Duration
+
+	now func() time.Time
+}
+
+func newRateLimiter(service string, limit RateLimit) *rateLimiter {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+
+	return &rateLimiter{
+		service: service,
+		limit:   limit,
+		tokens:  float64(limit.Burst),
+		now:     time.Now,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller has
+// to wait before the token is available.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.limit.RequestsPerSecond
+		if l.tokens > float64(l.limit.Burst) {
+			l.tokens = float64(l.limit.Burst)
+		}
+	}
+	l.last = now
+	l.tokens--
+	l.requests++
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	wait := time.Duration(-l.tokens / l.limit.RequestsPerSecond * float64(time.Second))
+	l.delayed++
+	l.waited += wait
+
+	return wait
+}
+
+// handler returns a named request handler waiting for a token of the bucket
+// before the request is signed and sent.
+func (l *rateLimiter) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			wait := l.reserve()
+			if wait <= 0 {
+				return
+			}
+
+			if err := aws.SleepWithContext(r.Context(), wait); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting for rate limit", err)
+				return
+			}
+
+			l.mu.Lock()
+			log.Printf("[DEBUG] Rate limited %s.%s request by %s (requests: %d, delayed: %d, total delay: %s)",
+				l.service, r.Operation.Name, wait, l.requests, l.delayed, l.waited)
+			l.mu.Unlock()
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Date(2018, 11, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter("ec2", RateLimit{RequestsPerSecond: 2, Burst: 3})
+	l.now = func() time.Time { return now }
+
+	// The burst is available immediately
+	for i := 0; i < 3; i++ {
+		if wait := l.reserve(); wait != 0 {
+			t.Fatalf("request %d: expected no wait within the burst, got %s", i, wait)
+		}
+	}
+
+	// Then each request waits for the next token
+	if wait := l.reserve(); wait != 500*time.Millisecond {
+		t.Fatalf("expected to wait 500ms, got %s", wait)
+	}
+	if wait := l.reserve(); wait != time.Second {
+		t.Fatalf("expected to wait 1s, got %s", wait)
+	}
+
+	// Tokens are refilled over time, up to the burst
+	now = now.Add(time.Minute)
+	for i := 0; i < 3; i++ {
+		if wait := l.reserve(); wait != 0 {
+			t.Fatalf("request %d: expected no wait after refill, got %s", i, wait)
+		}
+	}
+	if wait := l.reserve(); wait != 500*time.Millisecond {
+		t.Fatalf("expected to wait 500ms after the burst, got %s", wait)
+	}
+
+	if l.requests != 9 {
+		t.Fatalf("expected 9 requests, got %d", l.requests)
+	}
+	if l.delayed != 3 {
+		t.Fatalf("expected 3 delayed requests, got %d", l.delayed)
+	}
+	if l.waited != 2*time.Second {
+		t.Fatalf("expected a total delay of 2s, got %s", l.waited)
+	}
+}
+
+func TestRateLimiterMinimumBurst(t *testing.T) {
+	l := newRateLimiter("iam", RateLimit{RequestsPerSecond: 10})
+
+	if wait := l.reserve(); wait != 0 {
+		t.Fatalf("expected no wait for the first request, got %s", wait)
+	}
+}
+
+func TestConfigAddRateLimitHandler(t *testing.T) {
+	c := &Config{
+		RateLimits: map[string]RateLimit{
+			"ec2": {RequestsPerSecond: 10, Burst: 5},
+		},
+	}
+
+	closeSts, sess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ec2Sess := c.endpointSession(sess, "ec2")
+	if n := ec2Sess.Handlers.Sign.Len(); n != sess.Handlers.Sign.Len()+1 {
+		t.Fatalf("expected the rate limit handler on the ec2 session, got %d sign handlers", n)
+	}
+
+	iamSess := c.endpointSession(sess, "iam")
+	if n := iamSess.Handlers.Sign.Len(); n != sess.Handlers.Sign.Len() {
+		t.Fatalf("expected no rate limit handler on the iam session, got %d sign handlers", n)
+	}
+}
+
+func TestRateLimiterHandlerCanceled(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(400)
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: awsCredentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-east-1"),
+		MaxRetries:  aws.Int(0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Config{
+		Endpoints: map[string]string{
+			"sts": ts.URL,
+		},
+		RateLimits: map[string]RateLimit{
+			"sts": {RequestsPerSecond: 0.001, Burst: 1},
+		},
+	}
+	conn := sts.New(c.endpointSession(sess, "sts"))
+
+	// The first request takes the only token of the bucket
+	conn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("expected 1 request to reach the server, got %d", n)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = conn.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if !isAWSErr(err, request.CanceledErrorCode, "") || !strings.Contains(err.Error(), "waiting for rate limit") {
+		t.Fatalf("expected the request to be canceled while waiting for the rate limit, got: %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("expected the canceled request not to reach the server, got %d requests", n)
+	}
+}
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const redactedValue = "***"
+
+// defaultRedactedFields are the names of AWS API request and response fields
+// holding secrets in any service, redacted from the audit and debug logs.
+var defaultRedactedFields = []string{
+	"AuthToken",
+	"MasterUserPassword",
+	"NewPassword",
+	"OldPassword",
+	"Password",
+	"Plaintext",
+	"PrivateKey",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"SessionToken",
+}
+
+// sensitiveOperationFields are the names of the request and response fields
+// holding secrets of specific service operations, keyed by service and
+// operation name. They are redacted in addition to defaultRedactedFields.
+var sensitiveOperationFields = map[string][]string{
+	"acm/ExportCertificate":                  {"Passphrase"},
+	"acm/ImportCertificate":                  {"PrivateKey"},
+	"cognito-idp/AdminCreateUser":            {"TemporaryPassword"},
+	"cognito-idp/AdminSetUserPassword":       {"Password"},
+	"dms/CreateEndpoint":                     {"Password"},
+	"dms/ModifyEndpoint":                     {"Password"},
+	"ds/ConnectDirectory":                    {"CustomerPassword"},
+	"ds/CreateDirectory":                     {"Password"},
+	"ds/CreateMicrosoftAD":                   {"Password"},
+	"ec2/GetPasswordData":                    {"PasswordData"},
+	"elasticache/CreateReplicationGroup":     {"AuthToken"},
+	"iam/CreateAccessKey":                    {"SecretAccessKey"},
+	"iam/CreateServiceSpecificCredential":    {"ServicePassword"},
+	"iam/ResetServiceSpecificCredential":     {"ServicePassword"},
+	"iam/UploadServerCertificate":            {"PrivateKey"},
+	"kms/Decrypt":                            {"Plaintext"},
+	"kms/Encrypt":                            {"Plaintext"},
+	"kms/GenerateDataKey":                    {"Plaintext"},
+	"lightsail/GetInstanceAccessDetails":     {"Password", "PrivateKey"},
+	"mq/CreateBroker":                        {"Password"},
+	"mq/CreateUser":                          {"Password"},
+	"mq/UpdateUser":                          {"Password"},
+	"rds/CreateDBCluster":                    {"MasterUserPassword"},
+	"rds/CreateDBInstance":                   {"MasterUserPassword"},
+	"rds/ModifyDBCluster":                    {"MasterUserPassword"},
+	"rds/ModifyDBInstance":                   {"MasterUserPassword"},
+	"redshift/CreateCluster":                 {"MasterUserPassword"},
+	"redshift/ModifyCluster":                 {"MasterUserPassword"},
+	"secretsmanager/CreateSecret":            {"SecretBinary", "SecretString"},
+	"secretsmanager/GetSecretValue":          {"SecretBinary", "SecretString"},
+	"secretsmanager/PutSecretValue":          {"SecretBinary", "SecretString"},
+	"secretsmanager/UpdateSecret":            {"SecretBinary", "SecretString"},
+	"ssm/GetParameter":                       {"Value"},
+	"ssm/GetParameterHistory":                {"Value"},
+	"ssm/GetParameters":                      {"Value"},
+	"ssm/GetParametersByPath":                {"Value"},
+	"ssm/PutParameter":                       {"Value"},
+	"sts/AssumeRole":                         {"SecretAccessKey", "SessionToken"},
+	"sts/AssumeRoleWithSAML":                 {"SAMLAssertion", "SecretAccessKey", "SessionToken"},
+	"sts/AssumeRoleWithWebIdentity":          {"SecretAccessKey", "SessionToken", "WebIdentityToken"},
+	"sts/GetFederationToken":                 {"SecretAccessKey", "SessionToken"},
+	"sts/GetSessionToken":                    {"SecretAccessKey", "SessionToken"},
+	"storagegateway/SetLocalConsolePassword": {"LocalConsolePassword"},
+}
+
+// fieldRedactor redacts the values of fields by name, ignoring case.
+type fieldRedactor map[string]bool
+
+func newFieldRedactor(fields ...[]string) fieldRedactor {
+	r := make(fieldRedactor)
+	for _, f := range fields {
+		for _, name := range f {
+			r[strings.ToLower(name)] = true
+		}
+	}
+
+	return r
+}
+
+// withOperation returns a copy of the redactor also redacting the sensitive
+// fields of the service operation.
+func (r fieldRedactor) withOperation(service, operation string) fieldRedactor {
+	fields, ok := sensitiveOperationFields[service+"/"+operation]
+	if !ok {
+		return r
+	}
+
+	result := make(fieldRedactor, len(r)+len(fields))
+	for k := range r {
+		result[k] = true
+	}
+	for _, name := range fields {
+		result[strings.ToLower(name)] = true
+	}
+
+	return result
+}
+
+// redact replaces the values of the redacted fields in v, a value decoded
+// from JSON, at any depth.
+func (r fieldRedactor) redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if r[strings.ToLower(k)] {
+				if e != nil {
+					v[k] = redactedValue
+				}
+				continue
+			}
+			v[k] = r.redact(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = r.redact(e)
+		}
+	}
+
+	return v
+}
+
+// logMessageOperation matches the service and operation of the request and
+// response messages logged by the AWS SDK.
+var logMessageOperation = regexp.MustCompile(`^DEBUG(?: ERROR)?: (?:Request|Response) ([^/\s]+)/([^\s:]+)`)
+
+// logMessageSecurityToken matches the session token header of signed requests.
+var logMessageSecurityToken = regexp.MustCompile(`(?im)^(X-Amz-Security-Token:[ \t]*)[^\r\n]*`)
+
+// logMessageRedactors caches the redactors of logged messages by operation.
+var logMessageRedactors = struct {
+	sync.Mutex
+	m map[string]*logMessageRedactor
+}{m: make(map[string]*logMessageRedactor)}
+
+// logMessageRedactor masks the values of fields in JSON, XML and form encoded
+// bodies of logged HTTP requests and responses.
+type logMessageRedactor struct {
+	json  *regexp.Regexp
+	xml   *regexp.Regexp
+	query *regexp.Regexp
+}
+
+func newLogMessageRedactor(fields []string) *logMessageRedactor {
+	names := make([]string, len(fields))
+	for i, name := range fields {
+		names[i] = regexp.QuoteMeta(name)
+	}
+	pattern := strings.Join(names, "|")
+
+	return &logMessageRedactor{
+		json:  regexp.MustCompile(fmt.Sprintf(`("(?i:%s)"\s*:\s*)"(?:[^"\\]|\\.)*"`, pattern)),
+		xml:   regexp.MustCompile(fmt.Sprintf(`(<(?i:%s)>)[^<]*(</)`, pattern)),
+		query: regexp.MustCompile(fmt.Sprintf(`(?m)((?:^|[&?.])(?i:%s)=)[^&\s]*`, pattern)),
+	}
+}
+
+func (r *logMessageRedactor) redact(msg string) string {
+	msg = r.json.ReplaceAllString(msg, `${1}"`+redactedValue+`"`)
+	msg = r.xml.ReplaceAllString(msg, `${1}`+redactedValue+`${2}`)
+	msg = r.query.ReplaceAllString(msg, `${1}`+redactedValue)
+	return msg
+}
+
+// redactLogMessage masks the session token header and the values of the
+// sensitive fields of the logged service operation in a message of the AWS
+// SDK debug log.
+func redactLogMessage(msg string) string {
+	msg = logMessageSecurityToken.ReplaceAllString(msg, `${1}`+redactedValue)
+
+	key := ""
+	if m := logMessageOperation.FindStringSubmatch(msg); m != nil {
+		key = m[1] + "/" + m[2]
+	}
+
+	return operationLogMessageRedactor(key).redact(msg)
+}
+
+// operationLogMessageRedactor returns the redactor of the default redacted
+// fields and the sensitive fields of the operation, keyed by service and
+// operation name.
+func operationLogMessageRedactor(key string) *logMessageRedactor {
+	logMessageRedactors.Lock()
+	defer logMessageRedactors.Unlock()
+
+	r, ok := logMessageRedactors.m[key]
+	if !ok {
+		fields := append([]string{}, defaultRedactedFields...)
+		fields = append(fields, sensitiveOperationFields[key]...)
+		r = newLogMessageRedactor(fields)
+		logMessageRedactors.m[key] = r
+	}
+
+	return r
+}
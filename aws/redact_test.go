@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldRedactorRedact(t *testing.T) {
+	r := newFieldRedactor(defaultRedactedFields, []string{"userdata"})
+
+	v := map[string]interface{}{
+		"DBInstanceIdentifier": "test",
+		"MasterUserPassword":   "hunter22",
+		"UserData":             "IyEvYmluL2Jhc2g=",
+		"Credentials": map[string]interface{}{
+			"AccessKeyId":     "AKIAEXAMPLE",
+			"SecretAccessKey": "secret",
+			"SessionToken":    nil,
+		},
+		"Secrets": []interface{}{
+			map[string]interface{}{
+				"Name":         "test",
+				"SecretString": "secret",
+			},
+		},
+	}
+	expected := map[string]interface{}{
+		"DBInstanceIdentifier": "test",
+		"MasterUserPassword":   redactedValue,
+		"UserData":             redactedValue,
+		"Credentials": map[string]interface{}{
+			"AccessKeyId":     "AKIAEXAMPLE",
+			"SecretAccessKey": redactedValue,
+			"SessionToken":    nil,
+		},
+		"Secrets": []interface{}{
+			map[string]interface{}{
+				"Name":         "test",
+				"SecretString": redactedValue,
+			},
+		},
+	}
+
+	if got := r.redact(v); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, got)
+	}
+}
+
+func TestFieldRedactorWithOperation(t *testing.T) {
+	r := newFieldRedactor(defaultRedactedFields)
+
+	v := map[string]interface{}{
+		"Name":  "/app/db/password",
+		"Value": "hunter22",
+	}
+
+	if got := r.withOperation("ssm", "PutParameter").redact(v).(map[string]interface{}); got["Value"] != redactedValue {
+		t.Fatalf("Expected the ssm PutParameter value to be redacted, got %#v", got)
+	}
+	if r["value"] {
+		t.Fatal("Expected the operation fields not to be added to the shared redactor")
+	}
+}
+
+func TestRedactLogMessage(t *testing.T) {
+	cases := []struct {
+		Message  string
+		Expected string
+	}{
+		// Query protocol request
+		{
+			Message: "DEBUG: Request rds/CreateDBInstance Details:\n" +
+				"---[ REQUEST POST-SIGN ]-----------------------------\n" +
+				"POST / HTTP/1.1\r\n" +
+				"Host: rds.us-east-1.amazonaws.com\r\n" +
+				"X-Amz-Security-Token: FwoGZXIvYXdzEXAMPLE\r\n" +
+				"\r\n" +
+				"Action=CreateDBInstance&DBInstanceIdentifier=test&MasterUserPassword=hunter22&MasterUsername=admin\n" +
+				"-----------------------------------------------------",
+			Expected: "DEBUG: Request rds/CreateDBInstance Details:\n" +
+				"---[ REQUEST POST-SIGN ]-----------------------------\n" +
+				"POST / HTTP/1.1\r\n" +
+				"Host: rds.us-east-1.amazonaws.com\r\n" +
+				"X-Amz-Security-Token: ***\r\n" +
+				"\r\n" +
+				"Action=CreateDBInstance&DBInstanceIdentifier=test&MasterUserPassword=***&MasterUsername=admin\n" +
+				"-----------------------------------------------------",
+		},
+		// JSON protocol response
+		{
+			Message: "DEBUG: Response secretsmanager/GetSecretValue Details:\n" +
+				"---[ RESPONSE ]--------------------------------------\n" +
+				`{"ARN":"arn:aws:secretsmanager:us-east-1:123456789012:secret:test","SecretString":"{\"password\":\"hunter22\"}"}`,
+			Expected: "DEBUG: Response secretsmanager/GetSecretValue Details:\n" +
+				"---[ RESPONSE ]--------------------------------------\n" +
+				`{"ARN":"arn:aws:secretsmanager:us-east-1:123456789012:secret:test","SecretString":"***"}`,
+		},
+		// Operation specific field
+		{
+			Message: "DEBUG: Request ssm/PutParameter Details:\n" +
+				`{"Name":"/app/db/password","Type":"SecureString","Value":"hunter22"}`,
+			Expected: "DEBUG: Request ssm/PutParameter Details:\n" +
+				`{"Name":"/app/db/password","Type":"SecureString","Value":"***"}`,
+		},
+		// Operation specific field of another operation
+		{
+			Message: "DEBUG: Request ec2/CreateTags Details:\n" +
+				"Action=CreateTags&ResourceId.1=i-123&Tag.1.Key=Name&Tag.1.Value=web",
+			Expected: "DEBUG: Request ec2/CreateTags Details:\n" +
+				"Action=CreateTags&ResourceId.1=i-123&Tag.1.Key=Name&Tag.1.Value=web",
+		},
+		// XML response
+		{
+			Message: "DEBUG: Response iam/CreateAccessKey Details:\n" +
+				"<AccessKey><AccessKeyId>AKIAEXAMPLE</AccessKeyId><SecretAccessKey>wJalrXUtnFEMI</SecretAccessKey></AccessKey>",
+			Expected: "DEBUG: Response iam/CreateAccessKey Details:\n" +
+				"<AccessKey><AccessKeyId>AKIAEXAMPLE</AccessKeyId><SecretAccessKey>***</SecretAccessKey></AccessKey>",
+		},
+		// Lowercase JSON field
+		{
+			Message: "DEBUG: Request mq/CreateBroker Details:\n" +
+				`{"brokerName":"test","users":[{"password":"hunter22","username":"admin"}]}`,
+			Expected: "DEBUG: Request mq/CreateBroker Details:\n" +
+				`{"brokerName":"test","users":[{"password":"***","username":"admin"}]}`,
+		},
+	}
+
+	for i, tc := range cases {
+		if got := redactLogMessage(tc.Message); got != tc.Expected {
+			t.Fatalf("%d: expected:\n%s\n\ngot:\n%s", i, tc.Expected, got)
+		}
+	}
+}
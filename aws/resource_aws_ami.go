@@ -391,7 +391,7 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ebs_block_device", ebsBlockDevs)
 	d.Set("ephemeral_block_device", ephemeralBlockDevs)
 
-	d.Set("tags", tagsToMap(image.Tags))
+	d.Set("tags", tagsToMap(image.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -405,6 +405,7 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.Get("description").(string) != "" {
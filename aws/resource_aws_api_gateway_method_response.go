@@ -93,6 +93,7 @@ func resourceAwsApiGatewayMethodResponse() *schema.Resource {
 
 func resourceAwsApiGatewayMethodResponseCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
+	retryConfig := meta.(*AWSClient).retryConfig
 
 	models := make(map[string]string)
 	for k, v := range d.Get("response_models").(map[string]interface{}) {
@@ -118,7 +119,7 @@ func resourceAwsApiGatewayMethodResponseCreate(d *schema.ResourceData, meta inte
 	resourceAwsApiGatewayMethodResponseMutex.Lock()
 	defer resourceAwsApiGatewayMethodResponseMutex.Unlock()
 
-	_, err := retryOnAwsCode(apigateway.ErrCodeConflictException, func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, apigateway.ErrCodeConflictException, func() (interface{}, error) {
 		return conn.PutMethodResponse(&apigateway.PutMethodResponseInput{
 			HttpMethod:         aws.String(d.Get("http_method").(string)),
 			ResourceId:         aws.String(d.Get("resource_id").(string)),
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 	"time"
 
@@ -15,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	addTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    testSweepAPIGatewayRestApis,
 	})
@@ -37,14 +36,7 @@ func testSweepAPIGatewayRestApis(region string) error {
 
 	err = conn.GetRestApisPages(&apigateway.GetRestApisInput{}, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
 		for _, item := range page.Items {
-			skip := true
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(*item.Name, prefix) {
-					skip = false
-					break
-				}
-			}
-			if skip {
+			if testSweepSkipResourceName(*item.Name, prefixes...) {
 				log.Printf("[INFO] Skipping API Gateway REST API: %s", *item.Name)
 				continue
 			}
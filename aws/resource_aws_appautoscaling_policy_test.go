@@ -70,6 +70,12 @@ func TestAccAWSAppautoScalingPolicy_nestedSchema(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_appautoscaling_policy.foobar_simple", "scalable_dimension", "ecs:service:DesiredCount"),
 				),
 			},
+			{
+				ResourceName:      "aws_appautoscaling_policy.foobar_simple",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAppautoscalingPolicyImportStateIdFunc("aws_appautoscaling_policy.foobar_simple"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -765,3 +771,14 @@ resource "aws_appautoscaling_policy" "foobar_in" {
 }
 `, randClusterName, randPolicyNamePrefix, randPolicyNamePrefix)
 }
+
+func testAccAWSAppautoscalingPolicyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s/%s", rs.Primary.Attributes["service_namespace"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["scalable_dimension"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -18,6 +18,9 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 		Create: resourceAwsAppautoscalingScheduledActionPut,
 		Read:   resourceAwsAppautoscalingScheduledActionRead,
 		Delete: resourceAwsAppautoscalingScheduledActionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingScheduledActionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -38,6 +41,7 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 			"scalable_dimension": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"scalable_target_action": {
@@ -147,6 +151,7 @@ func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta i
 
 	saName := d.Get("name").(string)
 	input := &applicationautoscaling.DescribeScheduledActionsInput{
+		ResourceId:           aws.String(d.Get("resource_id").(string)),
 		ScheduledActionNames: []*string{aws.String(saName)},
 		ServiceNamespace:     aws.String(d.Get("service_namespace").(string)),
 	}
@@ -162,10 +167,24 @@ func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta i
 	if len(resp.ScheduledActions) != 1 {
 		return fmt.Errorf("Expected 1 scheduled action under %s, found %d", saName, len(resp.ScheduledActions))
 	}
-	if *resp.ScheduledActions[0].ScheduledActionName != saName {
+	sa := resp.ScheduledActions[0]
+	if *sa.ScheduledActionName != saName {
 		return fmt.Errorf("Scheduled Action (%s) not found", saName)
 	}
-	d.Set("arn", resp.ScheduledActions[0].ScheduledActionARN)
+	d.Set("arn", sa.ScheduledActionARN)
+	d.Set("resource_id", sa.ResourceId)
+	d.Set("scalable_dimension", sa.ScalableDimension)
+	d.Set("schedule", sa.Schedule)
+	if sa.StartTime != nil {
+		d.Set("start_time", sa.StartTime.UTC().Format(awsAppautoscalingScheduleTimeLayout))
+	}
+	if sa.EndTime != nil {
+		d.Set("end_time", sa.EndTime.UTC().Format(awsAppautoscalingScheduleTimeLayout))
+	}
+	if err := d.Set("scalable_target_action", flattenAppautoscalingScalableTargetAction(sa.ScalableTargetAction)); err != nil {
+		return fmt.Errorf("error setting scalable_target_action: %s", err)
+	}
+
 	return nil
 }
 
@@ -191,3 +210,33 @@ func resourceAwsAppautoscalingScheduledActionDelete(d *schema.ResourceData, meta
 
 	return nil
 }
+
+func resourceAwsAppautoscalingScheduledActionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	serviceNamespace, resourceId, idParts, err := splitAppautoscalingImportId(d.Id(), 1)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <service-namespace>/<resource-id>/<scheduled-action-name>", d.Id())
+	}
+
+	d.Set("service_namespace", serviceNamespace)
+	d.Set("resource_id", resourceId)
+	d.Set("name", idParts[0])
+	d.SetId(idParts[0] + "-" + serviceNamespace + "-" + resourceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenAppautoscalingScalableTargetAction(sta *applicationautoscaling.ScalableTargetAction) []interface{} {
+	if sta == nil {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+	if sta.MaxCapacity != nil {
+		m["max_capacity"] = int(aws.Int64Value(sta.MaxCapacity))
+	}
+	if sta.MinCapacity != nil {
+		m["min_capacity"] = int(aws.Int64Value(sta.MinCapacity))
+	}
+
+	return []interface{}{m}
+}
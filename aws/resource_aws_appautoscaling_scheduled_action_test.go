@@ -25,6 +25,12 @@ func TestAccAWSAppautoscalingScheduledAction_dynamo(t *testing.T) {
 					testAccCheckAwsAppautoscalingScheduledActionExists("aws_appautoscaling_scheduled_action.hoge"),
 				),
 			},
+			{
+				ResourceName:      "aws_appautoscaling_scheduled_action.hoge",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAppautoscalingScheduledActionImportStateIdFunc("aws_appautoscaling_scheduled_action.hoge"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -574,3 +580,14 @@ resource "aws_appautoscaling_scheduled_action" "hoge" {
 }
 `, rName, ts)
 }
+
+func testAccAWSAppautoscalingScheduledActionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["service_namespace"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -19,6 +20,9 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 		Read:   resourceAwsAppautoscalingTargetRead,
 		Update: resourceAwsAppautoscalingTargetPut,
 		Delete: resourceAwsAppautoscalingTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingTargetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"max_capacity": {
@@ -165,6 +169,40 @@ func resourceAwsAppautoscalingTargetDelete(d *schema.ResourceData, meta interfac
 	})
 }
 
+func resourceAwsAppautoscalingTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	serviceNamespace, resourceId, idParts, err := splitAppautoscalingImportId(d.Id(), 1)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <service-namespace>/<resource-id>/<scalable-dimension>", d.Id())
+	}
+
+	d.Set("service_namespace", serviceNamespace)
+	d.Set("resource_id", resourceId)
+	d.Set("scalable_dimension", idParts[0])
+	d.SetId(resourceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// splitAppautoscalingImportId splits an import ID of the form
+// <service-namespace>/<resource-id>/<part>... with n trailing parts. The
+// resource ID can contain slashes, e.g. service/cluster-name/service-name.
+func splitAppautoscalingImportId(id string, n int) (string, string, []string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < n+2 {
+		return "", "", nil, fmt.Errorf("expected at least %d parts, got %d", n+2, len(idParts))
+	}
+	for _, p := range idParts {
+		if p == "" {
+			return "", "", nil, fmt.Errorf("empty part")
+		}
+	}
+
+	serviceNamespace := idParts[0]
+	resourceId := strings.Join(idParts[1:len(idParts)-n], "/")
+
+	return serviceNamespace, resourceId, idParts[len(idParts)-n:], nil
+}
+
 func getAwsAppautoscalingTarget(resourceId, namespace, dimension string,
 	conn *applicationautoscaling.ApplicationAutoScaling) (*applicationautoscaling.ScalableTarget, error) {
 
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -43,6 +44,12 @@ func TestAccAWSAppautoScalingTarget_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_appautoscaling_target.bar", "max_capacity", "8"),
 				),
 			},
+			{
+				ResourceName:      "aws_appautoscaling_target.bar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAppautoscalingTargetImportStateIdFunc("aws_appautoscaling_target.bar"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -710,3 +717,66 @@ resource "aws_appautoscaling_target" "read" {
 }
 `, tableName)
 }
+
+func testAccAWSAppautoscalingTargetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["service_namespace"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["scalable_dimension"]), nil
+	}
+}
+
+func TestSplitAppautoscalingImportId(t *testing.T) {
+	cases := []struct {
+		Id               string
+		N                int
+		ServiceNamespace string
+		ResourceId       string
+		Parts            []string
+		ErrCount         int
+	}{
+		{
+			Id:               "ecs/service/cluster-name/service-name/ecs:service:DesiredCount",
+			N:                1,
+			ServiceNamespace: "ecs",
+			ResourceId:       "service/cluster-name/service-name",
+			Parts:            []string{"ecs:service:DesiredCount"},
+		},
+		{
+			Id:               "dynamodb/table/tf-acc-test/dynamodb:table:ReadCapacityUnits/policy-name",
+			N:                2,
+			ServiceNamespace: "dynamodb",
+			ResourceId:       "table/tf-acc-test",
+			Parts:            []string{"dynamodb:table:ReadCapacityUnits", "policy-name"},
+		},
+		{
+			Id:       "ecs/ecs:service:DesiredCount",
+			N:        1,
+			ErrCount: 1,
+		},
+		{
+			Id:       "ecs//ecs:service:DesiredCount",
+			N:        1,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		serviceNamespace, resourceId, parts, err := splitAppautoscalingImportId(tc.Id, tc.N)
+		if tc.ErrCount > 0 {
+			if err == nil {
+				t.Fatalf("expected an error for %q, got none", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", tc.Id, err)
+		}
+		if serviceNamespace != tc.ServiceNamespace || resourceId != tc.ResourceId || !reflect.DeepEqual(parts, tc.Parts) {
+			t.Fatalf("%q: expected %q, %q, %q, got %q, %q, %q", tc.Id, tc.ServiceNamespace, tc.ResourceId, tc.Parts, serviceNamespace, resourceId, parts)
+		}
+	}
+}
@@ -600,7 +600,13 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if !tagOk && !tagsOk {
-		d.Set("tag", autoscalingTagDescriptionsToSlice(g.Tags))
+		ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+		for _, t := range g.Tags {
+			if !ignoreTagsConfig.ignored(aws.StringValue(t.Key)) {
+				tagList = append(tagList, t)
+			}
+		}
+		d.Set("tag", autoscalingTagDescriptionsToSlice(tagList))
 	}
 
 	if len(*g.VPCZoneIdentifier) > 0 {
@@ -21,7 +21,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscaling_group", &resource.Sweeper{
+	addTestSweepers("aws_autoscaling_group", &resource.Sweeper{
 		Name: "aws_autoscaling_group",
 		F:    testSweepAutoscalingGroups,
 	})
@@ -49,15 +49,7 @@ func testSweepAutoscalingGroups(region string) error {
 	}
 
 	for _, asg := range resp.AutoScalingGroups {
-		var testOptGroup bool
-		for _, testName := range []string{"foobar", "terraform-", "tf-test", "tf-asg-"} {
-			if strings.HasPrefix(*asg.AutoScalingGroupName, testName) {
-				testOptGroup = true
-				break
-			}
-		}
-
-		if !testOptGroup {
+		if testSweepSkipResourceName(*asg.AutoScalingGroupName, "foobar", "terraform-", "tf-test", "tf-asg-") {
 			continue
 		}
 
@@ -19,6 +19,9 @@ func resourceAwsAutoscalingLifecycleHook() *schema.Resource {
 		Read:   resourceAwsAutoscalingLifecycleHookRead,
 		Update: resourceAwsAutoscalingLifecycleHookPut,
 		Delete: resourceAwsAutoscalingLifecycleHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingLifecycleHookImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -102,6 +105,7 @@ func resourceAwsAutoscalingLifecycleHookRead(d *schema.ResourceData, meta interf
 
 	log.Printf("[DEBUG] Read Lifecycle Hook: ASG: %s, SH: %s, Obj: %#v", d.Get("autoscaling_group_name"), d.Get("name"), p)
 
+	d.Set("autoscaling_group_name", p.AutoScalingGroupName)
 	d.Set("default_result", p.DefaultResult)
 	d.Set("heartbeat_timeout", p.HeartbeatTimeout)
 	d.Set("lifecycle_transition", p.LifecycleTransition)
@@ -134,6 +138,22 @@ func resourceAwsAutoscalingLifecycleHookDelete(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceAwsAutoscalingLifecycleHookImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <asg-name>/<lifecycle-hook-name>", d.Id())
+	}
+
+	asgName := idParts[0]
+	lifecycleHookName := idParts[1]
+
+	d.Set("autoscaling_group_name", asgName)
+	d.Set("name", lifecycleHookName)
+	d.SetId(lifecycleHookName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func getAwsAutoscalingPutLifecycleHookInput(d *schema.ResourceData) autoscaling.PutLifecycleHookInput {
 	var params = autoscaling.PutLifecycleHookInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
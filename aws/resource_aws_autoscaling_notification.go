@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -16,6 +17,9 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 		Read:   resourceAwsAutoscalingNotificationRead,
 		Update: resourceAwsAutoscalingNotificationUpdate,
 		Delete: resourceAwsAutoscalingNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingNotificationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"topic_arn": {
@@ -201,6 +205,17 @@ func resourceAwsAutoscalingNotificationDelete(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceAwsAutoscalingNotificationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := arn.Parse(d.Id()); err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <topic-arn>: %s", d.Id(), err)
+	}
+
+	// The notifications of every Autoscaling Group using the topic are read
+	d.Set("topic_arn", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func convertSetToList(s *schema.Set) (nl []*string) {
 	l := s.List()
 	for _, n := range l {
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsAutoscalingSchedule() *schema.Resource {
 		Read:   resourceAwsAutoscalingScheduleRead,
 		Update: resourceAwsAutoscalingScheduleCreate,
 		Delete: resourceAwsAutoscalingScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingScheduleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -189,6 +193,22 @@ func resourceAwsAutoscalingScheduleDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceAwsAutoscalingScheduleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <asg-name>/<scheduled-action-name>", d.Id())
+	}
+
+	asgName := idParts[0]
+	scheduledActionName := idParts[1]
+
+	d.Set("autoscaling_group_name", asgName)
+	d.Set("scheduled_action_name", scheduledActionName)
+	d.SetId(scheduledActionName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsASGScheduledActionRetrieve(d *schema.ResourceData, meta interface{}) (*autoscaling.ScheduledUpdateGroupAction, error, bool) {
 	autoscalingconn := meta.(*AWSClient).autoscalingconn
 
@@ -29,6 +29,12 @@ func TestAccAWSAutoscalingSchedule_basic(t *testing.T) {
 					testAccCheckScalingScheduleExists("aws_autoscaling_schedule.foobar", &schedule),
 				),
 			},
+			{
+				ResourceName:      "aws_autoscaling_schedule.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingScheduleImportStateIdFunc("aws_autoscaling_schedule.foobar"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -361,3 +367,14 @@ resource "aws_autoscaling_schedule" "foobar" {
     autoscaling_group_name = "${aws_autoscaling_group.foobar.name}"
 }`, r, r, start, end)
 }
+
+func testAccAWSAutoscalingScheduleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["autoscaling_group_name"], rs.Primary.Attributes["scheduled_action_name"]), nil
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 	"time"
 
@@ -16,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
+	addTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
 		Name: "aws_batch_compute_environment",
 		Dependencies: []string{
 			"aws_batch_job_queue",
@@ -46,18 +45,16 @@ func testSweepBatchComputeEnvironments(region string) error {
 	}
 	for _, computeEnvironment := range out.ComputeEnvironments {
 		name := computeEnvironment.ComputeEnvironmentName
-		skip := true
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(*name, prefix) {
-				skip = false
-				break
-			}
-		}
-		if skip {
+		if testSweepSkipResourceName(*name, prefixes...) {
 			log.Printf("[INFO] Skipping Batch Compute Environment: %s", *name)
 			continue
 		}
 
+		if *flagSweepDryRun {
+			log.Printf("[INFO] Sweeper dry run, skipping deletion of Batch Compute Environment: %s", *name)
+			continue
+		}
+
 		log.Printf("[INFO] Disabling Batch Compute Environment: %s", *name)
 		err := disableBatchComputeEnvironment(*name, 20*time.Minute, conn)
 		if err != nil {
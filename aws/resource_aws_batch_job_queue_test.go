@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+	addTestSweepers("aws_batch_job_queue", &resource.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    testSweepBatchJobQueues,
 	})
@@ -42,18 +42,16 @@ func testSweepBatchJobQueues(region string) error {
 	}
 	for _, jobQueue := range out.JobQueues {
 		name := jobQueue.JobQueueName
-		skip := true
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(*name, prefix) {
-				skip = false
-				break
-			}
-		}
-		if skip {
+		if testSweepSkipResourceName(*name, prefixes...) {
 			log.Printf("[INFO] Skipping Batch Job Queue: %s", *name)
 			continue
 		}
 
+		if *flagSweepDryRun {
+			log.Printf("[INFO] Sweeper dry run, skipping deletion of Batch Job Queue: %s", *name)
+			continue
+		}
+
 		log.Printf("[INFO] Disabling Batch Job Queue: %s", *name)
 		err := disableBatchJobQueue(*name, conn)
 		if err != nil {
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsBudgetsBudget() *schema.Resource {
@@ -130,6 +132,57 @@ func resourceAwsBudgetsBudget() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"notification": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ComparisonOperatorEqualTo,
+								budgets.ComparisonOperatorGreaterThan,
+								budgets.ComparisonOperatorLessThan,
+							}, false),
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"threshold_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ThresholdTypeAbsoluteValue,
+								budgets.ThresholdTypePercentage,
+							}, false),
+						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.NotificationTypeActual,
+								budgets.NotificationTypeForecasted,
+							}, false),
+						},
+						"subscriber_email_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subscriber_sns_topic_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 		Create: resourceAwsBudgetsBudgetCreate,
 		Read:   resourceAwsBudgetsBudgetRead,
@@ -138,7 +191,24 @@ func resourceAwsBudgetsBudget() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAwsBudgetsBudgetCustomizeDiff,
+	}
+}
+
+// resourceAwsBudgetsBudgetCustomizeDiff rejects notifications without any
+// subscribers at plan time, before the budget itself is created.
+func resourceAwsBudgetsBudgetCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("notification") {
+		return nil
+	}
+
+	for _, v := range diff.Get("notification").(*schema.Set).List() {
+		if len(expandBudgetsSubscribers(v.(map[string]interface{}))) == 0 {
+			return fmt.Errorf("budget notification must have at least one subscriber_email_addresses or subscriber_sns_topic_arns")
+		}
 	}
+
+	return nil
 }
 
 func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) error {
@@ -174,6 +244,13 @@ func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", accountID, *budget.BudgetName))
+
+	if v, ok := d.GetOk("notification"); ok {
+		if err := updateBudgetsBudgetNotifications(client, accountID, *budget.BudgetName, []interface{}{}, v.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsBudgetsBudgetRead(d, meta)
 }
 
@@ -230,11 +307,20 @@ func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.Set("time_unit", budget.TimeUnit)
 
+	notifications, err := describeBudgetsBudgetNotifications(client, accountID, budgetName)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("notification", notifications); err != nil {
+		return fmt.Errorf("error setting notification: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
-	accountID, _, err := decodeBudgetsBudgetID(d.Id())
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -253,6 +339,13 @@ func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("update budget failed: %v", err)
 	}
 
+	if d.HasChange("notification") {
+		o, n := d.GetChange("notification")
+		if err := updateBudgetsBudgetNotifications(client, accountID, budgetName, o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsBudgetsBudgetRead(d, meta)
 }
 
@@ -400,3 +493,273 @@ func expandBudgetsCostTypesUnmarshal(budgetCostTypes []interface{}) *budgets.Cos
 
 	return costTypes
 }
+
+func describeBudgetsBudgetNotifications(client *budgets.Budgets, accountID, budgetName string) ([]map[string]interface{}, error) {
+	input := &budgets.DescribeNotificationsForBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	}
+
+	var notifications []*budgets.Notification
+	for {
+		out, err := client.DescribeNotificationsForBudget(input)
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("describe notifications for budget failed: %v", err)
+		}
+
+		notifications = append(notifications, out.Notifications...)
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	result := make([]map[string]interface{}, 0, len(notifications))
+	for _, notification := range notifications {
+		subscribers, err := describeBudgetsBudgetSubscribers(client, accountID, budgetName, notification)
+		if err != nil {
+			return nil, err
+		}
+
+		emailAddresses := make([]interface{}, 0)
+		snsTopicArns := make([]interface{}, 0)
+		for _, subscriber := range subscribers {
+			switch aws.StringValue(subscriber.SubscriptionType) {
+			case budgets.SubscriptionTypeEmail:
+				emailAddresses = append(emailAddresses, aws.StringValue(subscriber.Address))
+			case budgets.SubscriptionTypeSns:
+				snsTopicArns = append(snsTopicArns, aws.StringValue(subscriber.Address))
+			}
+		}
+
+		// Notifications created before threshold types were introduced
+		// are percentage based.
+		thresholdType := aws.StringValue(notification.ThresholdType)
+		if thresholdType == "" {
+			thresholdType = budgets.ThresholdTypePercentage
+		}
+
+		result = append(result, map[string]interface{}{
+			"comparison_operator":        aws.StringValue(notification.ComparisonOperator),
+			"threshold":                  aws.Float64Value(notification.Threshold),
+			"threshold_type":             thresholdType,
+			"notification_type":          aws.StringValue(notification.NotificationType),
+			"subscriber_email_addresses": schema.NewSet(schema.HashString, emailAddresses),
+			"subscriber_sns_topic_arns":  schema.NewSet(schema.HashString, snsTopicArns),
+		})
+	}
+
+	return result, nil
+}
+
+func describeBudgetsBudgetSubscribers(client *budgets.Budgets, accountID, budgetName string, notification *budgets.Notification) ([]*budgets.Subscriber, error) {
+	input := &budgets.DescribeSubscribersForNotificationInput{
+		AccountId:    aws.String(accountID),
+		BudgetName:   aws.String(budgetName),
+		Notification: notification,
+	}
+
+	var subscribers []*budgets.Subscriber
+	for {
+		out, err := client.DescribeSubscribersForNotification(input)
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("describe subscribers for notification failed: %v", err)
+		}
+
+		subscribers = append(subscribers, out.Subscribers...)
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	return subscribers, nil
+}
+
+// updateBudgetsBudgetNotifications reconciles the notifications of a budget and
+// their subscribers from the old to the new configuration. Notifications present
+// in both are only updated if their subscribers differ, removed notifications are
+// replaced in place where possible and the remainder are deleted or created.
+func updateBudgetsBudgetNotifications(client *budgets.Budgets, accountID, budgetName string, oldNotifications, newNotifications []interface{}) error {
+	removed := make(map[string]map[string]interface{})
+	for _, v := range oldNotifications {
+		m := v.(map[string]interface{})
+		removed[budgetsNotificationKey(m)] = m
+	}
+
+	var added []map[string]interface{}
+	for _, v := range newNotifications {
+		m := v.(map[string]interface{})
+		key := budgetsNotificationKey(m)
+		if o, ok := removed[key]; ok {
+			delete(removed, key)
+			if err := updateBudgetsBudgetSubscribers(client, accountID, budgetName, expandBudgetsNotification(m), o, m); err != nil {
+				return err
+			}
+			continue
+		}
+
+		added = append(added, m)
+	}
+
+	removedKeys := make([]string, 0, len(removed))
+	for k := range removed {
+		removedKeys = append(removedKeys, k)
+	}
+	sort.Strings(removedKeys)
+
+	for len(removedKeys) > len(added) {
+		notification := expandBudgetsNotification(removed[removedKeys[0]])
+		removedKeys = removedKeys[1:]
+
+		log.Printf("[DEBUG] Deleting Budget (%s) notification: %s", budgetName, notification)
+		_, err := client.DeleteNotification(&budgets.DeleteNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification,
+		})
+		if err != nil && !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return fmt.Errorf("delete notification failed: %v", err)
+		}
+	}
+
+	for i, m := range added {
+		notification := expandBudgetsNotification(m)
+
+		if i < len(removedKeys) {
+			o := removed[removedKeys[i]]
+
+			log.Printf("[DEBUG] Updating Budget (%s) notification: %s", budgetName, notification)
+			_, err := client.UpdateNotification(&budgets.UpdateNotificationInput{
+				AccountId:       aws.String(accountID),
+				BudgetName:      aws.String(budgetName),
+				OldNotification: expandBudgetsNotification(o),
+				NewNotification: notification,
+			})
+			if err != nil {
+				return fmt.Errorf("update notification failed: %v", err)
+			}
+
+			if err := updateBudgetsBudgetSubscribers(client, accountID, budgetName, notification, o, m); err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] Creating Budget (%s) notification: %s", budgetName, notification)
+		_, err := client.CreateNotification(&budgets.CreateNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification,
+			Subscribers:  expandBudgetsSubscribers(m),
+		})
+		if err != nil {
+			return fmt.Errorf("create notification failed: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// updateBudgetsBudgetSubscribers reconciles the subscribers of a single notification.
+// New subscribers are created before old ones are deleted as a notification
+// can't be left without subscribers.
+func updateBudgetsBudgetSubscribers(client *budgets.Budgets, accountID, budgetName string, notification *budgets.Notification, o, n map[string]interface{}) error {
+	for _, subscriber := range budgetsSubscribersDifference(n, o) {
+		log.Printf("[DEBUG] Creating Budget (%s) subscriber: %s", budgetName, subscriber)
+		_, err := client.CreateSubscriber(&budgets.CreateSubscriberInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification,
+			Subscriber:   subscriber,
+		})
+		if err != nil {
+			return fmt.Errorf("create subscriber failed: %v", err)
+		}
+	}
+
+	for _, subscriber := range budgetsSubscribersDifference(o, n) {
+		log.Printf("[DEBUG] Deleting Budget (%s) subscriber: %s", budgetName, subscriber)
+		_, err := client.DeleteSubscriber(&budgets.DeleteSubscriberInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification,
+			Subscriber:   subscriber,
+		})
+		if err != nil && !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return fmt.Errorf("delete subscriber failed: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func budgetsNotificationKey(m map[string]interface{}) string {
+	return fmt.Sprintf("%s:%s:%g:%s",
+		m["notification_type"].(string),
+		m["comparison_operator"].(string),
+		m["threshold"].(float64),
+		m["threshold_type"].(string))
+}
+
+func expandBudgetsNotification(m map[string]interface{}) *budgets.Notification {
+	return &budgets.Notification{
+		ComparisonOperator: aws.String(m["comparison_operator"].(string)),
+		NotificationType:   aws.String(m["notification_type"].(string)),
+		Threshold:          aws.Float64(m["threshold"].(float64)),
+		ThresholdType:      aws.String(m["threshold_type"].(string)),
+	}
+}
+
+func expandBudgetsSubscribers(m map[string]interface{}) []*budgets.Subscriber {
+	subscribers := make([]*budgets.Subscriber, 0)
+
+	if v, ok := m["subscriber_email_addresses"].(*schema.Set); ok {
+		for _, address := range v.List() {
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(address.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeEmail),
+			})
+		}
+	}
+
+	if v, ok := m["subscriber_sns_topic_arns"].(*schema.Set); ok {
+		for _, address := range v.List() {
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(address.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeSns),
+			})
+		}
+	}
+
+	return subscribers
+}
+
+// budgetsSubscribersDifference returns the subscribers of a that are not subscribers of b.
+func budgetsSubscribersDifference(a, b map[string]interface{}) []*budgets.Subscriber {
+	existing := make(map[string]bool)
+	for _, subscriber := range expandBudgetsSubscribers(b) {
+		existing[budgetsSubscriberKey(subscriber)] = true
+	}
+
+	var subscribers []*budgets.Subscriber
+	for _, subscriber := range expandBudgetsSubscribers(a) {
+		if !existing[budgetsSubscriberKey(subscriber)] {
+			subscribers = append(subscribers, subscriber)
+		}
+	}
+
+	return subscribers
+}
+
+func budgetsSubscriberKey(subscriber *budgets.Subscriber) string {
+	return fmt.Sprintf("%s:%s", aws.StringValue(subscriber.SubscriptionType), aws.StringValue(subscriber.Address))
+}
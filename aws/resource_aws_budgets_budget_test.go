@@ -118,6 +118,49 @@ func TestAccAWSBudgetsBudget_prefix(t *testing.T) {
 	})
 }
 
+func TestAccAWSBudgetsBudget_notification(t *testing.T) {
+	name := fmt.Sprintf("test-budget-%d", acctest.RandInt())
+	resourceName := "aws_budgets_budget.foo"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSBudgetsBudgetConfig_NotificationWithoutSubscribers(name),
+				ExpectError: regexp.MustCompile("at least one subscriber"),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Notification(name, 80, `"user@example.com"`, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetNotifications(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Notification(name, 90, `"user@example.com", "other@example.com"`, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetNotifications(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Notification(name, 90, `"other@example.com"`, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetNotifications(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccAWSBudgetsBudgetExists(resourceName string, config budgets.Budget) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -164,6 +207,32 @@ func testAccAWSBudgetsBudgetExists(resourceName string, config budgets.Budget) r
 	}
 }
 
+func testAccAWSBudgetsBudgetNotifications(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		accountID, budgetName, err := decodeBudgetsBudgetID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("failed decoding ID: %v", err)
+		}
+
+		client := testAccProvider.Meta().(*AWSClient).budgetconn
+		notifications, err := describeBudgetsBudgetNotifications(client, accountID, budgetName)
+		if err != nil {
+			return err
+		}
+
+		if len(notifications) != expected {
+			return fmt.Errorf("expected %d notifications, got %d", expected, len(notifications))
+		}
+
+		return nil
+	}
+}
+
 func testAccAWSBudgetsBudgetCheckTimePeriod(configTimePeriod, timePeriod budgets.TimePeriod) error {
 	if configTimePeriod.End.Format("2006-01-02_15:04") != timePeriod.End.Format("2006-01-02_15:04") {
 		return fmt.Errorf("TimePeriodEnd not set properly '%v' should be '%v'", *timePeriod.End, *configTimePeriod.End)
@@ -423,3 +492,81 @@ resource "aws_budgets_budget" "foo" {
 	t.Execute(&doc, budgetConfig)
 	return doc.String()
 }
+
+func testAccAWSBudgetsBudgetConfig_Notification(name string, threshold int, emailAddresses string, forecasted bool) string {
+	config := fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = "%s"
+}
+
+resource "aws_sns_topic_policy" "test" {
+  arn = "${aws_sns_topic.test.arn}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "budgets.amazonaws.com"
+      },
+      "Action": "SNS:Publish",
+      "Resource": "${aws_sns_topic.test.arn}"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_budgets_budget" "foo" {
+  name              = "%s"
+  budget_type       = "COST"
+  limit_amount      = "100.0"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_12:00"
+  time_unit         = "MONTHLY"
+
+  notification {
+    comparison_operator        = "GREATER_THAN"
+    threshold                  = %d
+    threshold_type             = "PERCENTAGE"
+    notification_type          = "ACTUAL"
+    subscriber_email_addresses = [%s]
+  }
+`, name, name, threshold, emailAddresses)
+
+	if forecasted {
+		config += `
+  notification {
+    comparison_operator       = "GREATER_THAN"
+    threshold                 = 100
+    threshold_type            = "PERCENTAGE"
+    notification_type         = "FORECASTED"
+    subscriber_sns_topic_arns = ["${aws_sns_topic_policy.test.arn}"]
+  }
+`
+	}
+
+	return config + "}\n"
+}
+
+func testAccAWSBudgetsBudgetConfig_NotificationWithoutSubscribers(name string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "foo" {
+  name              = "%s"
+  budget_type       = "COST"
+  limit_amount      = "100.0"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_12:00"
+  time_unit         = "MONTHLY"
+
+  notification {
+    comparison_operator = "GREATER_THAN"
+    threshold           = 80
+    threshold_type      = "PERCENTAGE"
+    notification_type   = "ACTUAL"
+  }
+}
+`, name)
+}
@@ -247,6 +247,21 @@ func resourceAwsCloudHsm2ClusterRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error saving Subnet IDs to state for CloudHSMv2 Cluster (%s): %s", d.Id(), err)
 	}
 
+	var tags []*cloudhsmv2.Tag
+	err = meta.(*AWSClient).cloudhsmv2conn.ListTagsPages(&cloudhsmv2.ListTagsInput{
+		ResourceId: aws.String(d.Id()),
+	}, func(page *cloudhsmv2.ListTagsOutput, lastPage bool) bool {
+		tags = append(tags, page.TagList...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapCloudHsm2(tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
@@ -303,8 +318,8 @@ func resourceAwsCloudHsm2ClusterDelete(d *schema.ResourceData, meta interface{})
 }
 
 func setTagsAwsCloudHsm2Cluster(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
 
 		if len(remove) > 0 {
@@ -361,3 +376,14 @@ func readCloudHsm2ClusterCertificates(cluster *cloudhsmv2.Cluster) []map[string]
 	}
 	return []map[string]interface{}{}
 }
+
+func tagsToMapCloudHsm2(ts []*cloudhsmv2.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
+	result := make(map[string]string, len(ts))
+	for _, t := range ts {
+		if !tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig) {
+			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+	}
+
+	return result
+}
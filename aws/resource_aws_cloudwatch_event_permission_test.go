@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 	"time"
 
@@ -18,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
+	addTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_permission",
 		F:    testSweepCloudWatchEventPermissions,
 	})
@@ -56,7 +55,7 @@ func testSweepCloudWatchEventPermissions(region string) error {
 	for _, statement := range policyDoc.Statements {
 		sid := statement.Sid
 
-		if !strings.HasPrefix(sid, "TestAcc") {
+		if testSweepSkipResourceName(sid, "TestAcc") {
 			continue
 		}
 
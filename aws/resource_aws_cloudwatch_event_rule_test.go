@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
+	addTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_rule",
 		F:    testSweepCloudWatchEventRules,
 	})
@@ -48,7 +47,7 @@ func testSweepCloudWatchEventRules(region string) error {
 		for _, rule := range output.Rules {
 			name := aws.StringValue(rule.Name)
 
-			if !strings.HasPrefix(name, "tf") {
+			if testSweepSkipResourceName(name, "tf") {
 				continue
 			}
 
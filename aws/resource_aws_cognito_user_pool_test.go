@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
+	addTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
 		Name: "aws_cognito_user_pool",
 		F:    testSweepCognitoUserPools,
 	})
@@ -52,7 +51,7 @@ func testSweepCognitoUserPools(region string) error {
 		for _, userPool := range output.UserPools {
 			name := aws.StringValue(userPool.Name)
 
-			if !strings.HasPrefix(name, "tf_acc_") {
+			if testSweepSkipResourceName(name, "tf_acc_") {
 				continue
 			}
 
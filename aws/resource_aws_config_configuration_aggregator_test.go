@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	addTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    testSweepConfigConfigurationAggregators,
 	})
@@ -45,7 +44,7 @@ func testSweepConfigConfigurationAggregators(region string) error {
 	log.Printf("[INFO] Found %d config configuration aggregators", len(resp.ConfigurationAggregators))
 
 	for _, agg := range resp.ConfigurationAggregators {
-		if !strings.HasPrefix(*agg.ConfigurationAggregatorName, "tf-") {
+		if testSweepSkipResourceName(*agg.ConfigurationAggregatorName, "tf-") {
 			continue
 		}
 
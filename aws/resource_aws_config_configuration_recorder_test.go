@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
+	addTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
 		Name: "aws_config_configuration_recorder",
 		F:    testSweepConfigConfigurationRecorder,
 	})
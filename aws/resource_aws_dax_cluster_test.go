@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dax_cluster", &resource.Sweeper{
+	addTestSweepers("aws_dax_cluster", &resource.Sweeper{
 		Name: "aws_dax_cluster",
 		F:    testSweepDAXClusters,
 	})
@@ -47,7 +46,7 @@ func testSweepDAXClusters(region string) error {
 	log.Printf("[INFO] Found %d DAX clusters", len(resp.Clusters))
 
 	for _, cluster := range resp.Clusters {
-		if !strings.HasPrefix(*cluster.ClusterName, "tf-") {
+		if testSweepSkipResourceName(*cluster.ClusterName, "tf-") {
 			continue
 		}
 
@@ -5,7 +5,6 @@ import (
 	"log"
 	"os"
 	"regexp"
-	"strings"
 	"testing"
 	"time"
 
@@ -18,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_instance", &resource.Sweeper{
+	addTestSweepers("aws_db_instance", &resource.Sweeper{
 		Name: "aws_db_instance",
 		F:    testSweepDbInstances,
 	})
@@ -41,13 +40,7 @@ func testSweepDbInstances(region string) error {
 
 	err = conn.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(out *rds.DescribeDBInstancesOutput, lastPage bool) bool {
 		for _, dbi := range out.DBInstances {
-			hasPrefix := false
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(*dbi.DBInstanceIdentifier, prefix) {
-					hasPrefix = true
-				}
-			}
-			if !hasPrefix {
+			if testSweepSkipResourceName(*dbi.DBInstanceIdentifier, prefixes...) {
 				continue
 			}
 			log.Printf("[INFO] Deleting DB instance: %s", *dbi.DBInstanceIdentifier)
@@ -62,6 +55,10 @@ func testSweepDbInstances(region string) error {
 				continue
 			}
 
+			if *flagSweepDryRun {
+				continue
+			}
+
 			err = waitUntilAwsDbInstanceIsDeleted(*dbi.DBInstanceIdentifier, conn, 40*time.Minute)
 			if err != nil {
 				log.Printf("[ERROR] Failure while waiting for DB instance %s to be deleted: %s",
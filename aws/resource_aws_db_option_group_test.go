@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 	"time"
 
@@ -17,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
+	addTestSweepers("aws_db_option_group", &resource.Sweeper{
 		Name: "aws_db_option_group",
 		F:    testSweepDbOptionGroups,
 	})
@@ -42,14 +41,7 @@ func testSweepDbOptionGroups(region string) error {
 	}
 
 	for _, og := range resp.OptionGroupsList {
-		var testOptGroup bool
-		for _, testName := range []string{"option-group-test-terraform-", "tf-test"} {
-			if strings.HasPrefix(*og.OptionGroupName, testName) {
-				testOptGroup = true
-			}
-		}
-
-		if !testOptGroup {
+		if testSweepSkipResourceName(*og.OptionGroupName, "option-group-test-terraform-", "tf-test") {
 			continue
 		}
 
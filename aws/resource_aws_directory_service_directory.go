@@ -463,7 +463,7 @@ func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return fmt.Errorf("Failed to get Directory service tags (id: %s): %s", d.Id(), err)
 	}
-	d.Set("tags", tagsToMapDS(tagList.Tags))
+	d.Set("tags", tagsToMapDS(tagList.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
+	addTestSweepers("aws_directory_service_directory", &resource.Sweeper{
 		Name: "aws_directory_service_directory",
 		F:    testSweepDirectoryServiceDirectories,
 	})
@@ -61,6 +61,10 @@ func testSweepDirectoryServiceDirectories(region string) error {
 				return fmt.Errorf("error deleting Directory Service Directory (%s): %s", id, err)
 			}
 
+			if *flagSweepDryRun {
+				continue
+			}
+
 			log.Printf("[INFO] Waiting for Directory Service Directory (%q) to be deleted", id)
 			err = waitForDirectoryServiceDirectoryDeletion(conn, id)
 			if err != nil {
@@ -118,8 +122,8 @@ func TestDiffTagsDirectoryService(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsDS(tagsFromMapDS(tc.Old), tagsFromMapDS(tc.New))
-		cm := tagsToMapDS(c)
-		rm := tagsToMapDS(r)
+		cm := tagsToMapDS(c, nil)
+		rm := tagsToMapDS(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -117,7 +117,7 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("location", connection.Location)
 	d.Set("jumbo_frame_capable", connection.JumboFrameCapable)
 
-	if err := getTagsDX(conn, d, arn); err != nil {
+	if err := getTagsDX(conn, d, arn, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
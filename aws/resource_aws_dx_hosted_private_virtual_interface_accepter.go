@@ -120,7 +120,7 @@ func resourceAwsDxHostedPrivateVirtualInterfaceAccepterRead(d *schema.ResourceDa
 	d.Set("virtual_interface_id", vif.VirtualInterfaceId)
 	d.Set("vpn_gateway_id", vif.VirtualGatewayId)
 	d.Set("dx_gateway_id", vif.DirectConnectGatewayId)
-	if err := getTagsDX(conn, d, d.Get("arn").(string)); err != nil {
+	if err := getTagsDX(conn, d, d.Get("arn").(string), meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
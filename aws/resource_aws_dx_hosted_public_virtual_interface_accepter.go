@@ -94,7 +94,7 @@ func resourceAwsDxHostedPublicVirtualInterfaceAccepterRead(d *schema.ResourceDat
 	}
 
 	d.Set("virtual_interface_id", vif.VirtualInterfaceId)
-	if err := getTagsDX(conn, d, d.Get("arn").(string)); err != nil {
+	if err := getTagsDX(conn, d, d.Get("arn").(string), meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -136,7 +136,7 @@ func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("connections_bandwidth", lag.ConnectionsBandwidth)
 	d.Set("location", lag.Location)
 
-	if err := getTagsDX(conn, d, arn); err != nil {
+	if err := getTagsDX(conn, d, arn, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -174,6 +174,7 @@ func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	addTestSweepers("aws_dynamodb_table", &resource.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    testSweepDynamoDbTables,
 	})
@@ -37,11 +36,9 @@ func testSweepDynamoDbTables(region string) error {
 
 	err = conn.ListTablesPages(&dynamodb.ListTablesInput{}, func(out *dynamodb.ListTablesOutput, lastPage bool) bool {
 		for _, tableName := range out.TableNames {
-			for _, prefix := range prefixes {
-				if !strings.HasPrefix(*tableName, prefix) {
-					log.Printf("[INFO] Skipping DynamoDB Table: %s", *tableName)
-					continue
-				}
+			if testSweepSkipResourceName(*tableName, prefixes...) {
+				log.Printf("[INFO] Skipping DynamoDB Table: %s", *tableName)
+				continue
 			}
 			log.Printf("[INFO] Deleting DynamoDB Table: %s", *tableName)
 
@@ -139,7 +139,7 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("kms_key_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(snapshot.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
 	}
 
@@ -133,7 +133,7 @@ func resourceAwsEbsSnapshotCopyRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("kms_key_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(snapshot.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
 	}
 
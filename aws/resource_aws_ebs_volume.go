@@ -91,7 +91,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 	if value, ok := d.GetOk("snapshot_id"); ok {
 		request.SnapshotId = aws.String(value.(string))
 	}
-	if value, ok := d.GetOk("tags"); ok {
+	if value, ok := d.GetOk("tags_all"); ok {
 		request.TagSpecifications = []*ec2.TagSpecification{
 			{
 				ResourceType: aws.String(ec2.ResourceTypeVolume),
@@ -151,7 +151,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(conn, d); err != nil {
 			return fmt.Errorf("Error updating tags for EBS Volume: %s", err)
 		}
@@ -228,6 +228,31 @@ func volumeStateRefreshFunc(conn *ec2.EC2, volumeID string) resource.StateRefres
 	}
 }
 
+// volumeModificationStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// the state of the most recent modification of a Volume.
+func volumeModificationStateRefreshFunc(conn *ec2.EC2, volumeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeVolumesModifications(&ec2.DescribeVolumesModificationsInput{
+			VolumeIds: []*string{aws.String(volumeID)},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp == nil || len(resp.VolumesModifications) == 0 {
+			return nil, "", nil
+		}
+
+		m := resp.VolumesModifications[0]
+		state := aws.StringValue(m.ModificationState)
+		if state == ec2.VolumeModificationStateFailed {
+			return m, state, fmt.Errorf("volume modification failed: %s", aws.StringValue(m.StatusMessage))
+		}
+
+		return m, state, nil
+	}
+}
+
 func resourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -312,7 +337,7 @@ func readVolume(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume) e
 		}
 	}
 
-	d.Set("tags", tagsToMap(volume.Tags))
+	d.Set("tags", tagsToMap(volume.Tags, client.ignoreTagsConfig))
 
 	return nil
 }
@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	addTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    testSweepEc2CapacityReservations,
 	})
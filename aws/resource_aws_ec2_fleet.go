@@ -318,7 +318,7 @@ func resourceAwsEc2FleetCreate(d *schema.ResourceData, meta interface{}) error {
 		SpotOptions:                      expandEc2SpotOptionsRequest(d.Get("spot_options").([]interface{})),
 		TargetCapacitySpecification:      expandEc2TargetCapacitySpecificationRequest(d.Get("target_capacity_specification").([]interface{})),
 		TerminateInstancesWithExpiration: aws.Bool(d.Get("terminate_instances_with_expiration").(bool)),
-		TagSpecifications:                expandEc2TagSpecifications(d.Get("tags").(map[string]interface{})),
+		TagSpecifications:                expandEc2TagSpecifications(d.Get("tags_all").(map[string]interface{})),
 		Type:                             aws.String(d.Get("type").(string)),
 	}
 
@@ -441,7 +441,7 @@ func resourceAwsEc2FleetRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("terminate_instances_with_expiration", fleet.TerminateInstancesWithExpiration)
 	d.Set("type", fleet.Type)
 
-	if err := d.Set("tags", tagsToMap(fleet.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(fleet.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 	"time"
 
@@ -16,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
+	addTestSweepers("aws_eks_cluster", &resource.Sweeper{
 		Name: "aws_eks_cluster",
 		F:    testSweepEksClusters,
 	})
@@ -48,7 +47,7 @@ func testSweepEksClusters(region string) error {
 		for _, cluster := range out.Clusters {
 			name := aws.StringValue(cluster)
 
-			if !strings.HasPrefix(name, "tf-acc-test-") {
+			if testSweepSkipResourceName(name) {
 				log.Printf("[INFO] Skipping EKS Cluster: %s", name)
 				continue
 			}
@@ -59,6 +58,10 @@ func testSweepEksClusters(region string) error {
 				log.Printf("[ERROR] Failed to delete EKS Cluster %s: %s", name, err)
 				continue
 			}
+			if *flagSweepDryRun {
+				continue
+			}
+
 			err = waitForDeleteEksCluster(conn, name, 15*time.Minute)
 			if err != nil {
 				log.Printf("[ERROR] Failed to wait for EKS Cluster %s deletion: %s", name, err)
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,7 +15,7 @@ import (
 
 // initialize sweeper
 func init() {
-	resource.AddTestSweepers("aws_beanstalk_application", &resource.Sweeper{
+	addTestSweepers("aws_beanstalk_application", &resource.Sweeper{
 		Name:         "aws_beanstalk_application",
 		Dependencies: []string{"aws_beanstalk_environment"},
 		F:            testSweepBeanstalkApplications,
@@ -45,19 +44,7 @@ func testSweepBeanstalkApplications(region string) error {
 	}
 
 	for _, bsa := range resp.Applications {
-		var testOptGroup bool
-		for _, testName := range []string{
-			"terraform-",
-			"tf-test-",
-			"tf_acc_",
-			"tf-acc-",
-		} {
-			if strings.HasPrefix(*bsa.ApplicationName, testName) {
-				testOptGroup = true
-			}
-		}
-
-		if !testOptGroup {
+		if testSweepSkipResourceName(*bsa.ApplicationName, "terraform-", "tf-test-", "tf_acc_", "tf-acc-") {
 			continue
 		}
 
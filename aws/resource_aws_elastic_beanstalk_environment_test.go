@@ -6,7 +6,6 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
-	"strings"
 	"testing"
 	"time"
 
@@ -20,7 +19,7 @@ import (
 
 // initialize sweeper
 func init() {
-	resource.AddTestSweepers("aws_beanstalk_environment", &resource.Sweeper{
+	addTestSweepers("aws_beanstalk_environment", &resource.Sweeper{
 		Name: "aws_beanstalk_environment",
 		F:    testSweepBeanstalkEnvironments,
 	})
@@ -51,19 +50,7 @@ func testSweepBeanstalkEnvironments(region string) error {
 	}
 
 	for _, bse := range resp.Environments {
-		var testOptGroup bool
-		for _, testName := range []string{
-			"terraform-",
-			"tf-test-",
-			"tf_acc_",
-			"tf-acc-",
-		} {
-			if strings.HasPrefix(*bse.EnvironmentName, testName) {
-				testOptGroup = true
-			}
-		}
-
-		if !testOptGroup {
+		if testSweepSkipResourceName(*bse.EnvironmentName, "terraform-", "tf-test-", "tf_acc_", "tf-acc-") {
 			log.Printf("Skipping (%s) (%s)", *bse.EnvironmentName, *bse.EnvironmentId)
 			continue
 		}
@@ -86,6 +73,10 @@ func testSweepBeanstalkEnvironments(region string) error {
 			return err
 		}
 
+		if *flagSweepDryRun {
+			continue
+		}
+
 		waitForReadyTimeOut, _ := time.ParseDuration("5m")
 		pollInterval, _ := time.ParseDuration("10s")
 
@@ -760,7 +751,7 @@ func testAccCheckBeanstalkEnvTagsMatch(env *elasticbeanstalk.EnvironmentDescript
 			return err
 		}
 
-		foundTags := tagsToMapBeanstalk(tags.ResourceTags)
+		foundTags := tagsToMapBeanstalk(tags.ResourceTags, nil)
 
 		if !reflect.DeepEqual(foundTags, expectedValue) {
 			return fmt.Errorf("Tag value: %s.  Expected %s", foundTags, expectedValue)
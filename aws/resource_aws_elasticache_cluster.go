@@ -310,7 +310,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 		securityIdSet := d.Get("security_group_ids").(*schema.Set)
 		securityNames := expandStringList(securityNameSet.List())
 		securityIds := expandStringList(securityIdSet.List())
-		tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+		tags := tagsFromMapEC(d.Get("tags_all").(map[string]interface{}))
 
 		req.CacheSecurityGroupNames = securityNames
 		req.SecurityGroupIds = securityIds
@@ -492,7 +492,7 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 		if len(resp.TagList) > 0 {
 			et = resp.TagList
 		}
-		d.Set("tags", tagsToMapEC(et))
+		d.Set("tags", tagsToMapEC(et, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	return nil
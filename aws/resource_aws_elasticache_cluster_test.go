@@ -20,7 +20,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
+	addTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
 		Name: "aws_elasticache_cluster",
 		F:    testSweepElasticacheClusters,
 		Dependencies: []string{
@@ -50,14 +50,7 @@ func testSweepElasticacheClusters(region string) error {
 
 		for _, cluster := range page.CacheClusters {
 			id := aws.StringValue(cluster.CacheClusterId)
-			skip := true
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(id, prefix) {
-					skip = false
-					break
-				}
-			}
-			if skip {
+			if testSweepSkipResourceName(id, prefixes...) {
 				log.Printf("[INFO] Skipping Elasticache Cluster: %s", id)
 				continue
 			}
@@ -66,6 +59,9 @@ func testSweepElasticacheClusters(region string) error {
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Elasticache Cache Cluster (%s): %s", id, err)
 			}
+			if *flagSweepDryRun {
+				continue
+			}
 			err = waitForDeleteElasticacheCacheCluster(conn, id, 40*time.Minute)
 			if err != nil {
 				log.Printf("[ERROR] Failed waiting for Elasticache Cache Cluster (%s) to be deleted: %s", id, err)
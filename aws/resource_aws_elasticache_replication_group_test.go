@@ -5,7 +5,6 @@ import (
 	"log"
 	"os"
 	"regexp"
-	"strings"
 	"testing"
 	"time"
 
@@ -18,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
+	addTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_replication_group",
 		F:    testSweepElasticacheReplicationGroups,
 	})
@@ -45,17 +44,14 @@ func testSweepElasticacheReplicationGroups(region string) error {
 
 		for _, replicationGroup := range page.ReplicationGroups {
 			id := aws.StringValue(replicationGroup.ReplicationGroupId)
-			skip := true
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(id, prefix) {
-					skip = false
-					break
-				}
-			}
-			if skip {
+			if testSweepSkipResourceName(id, prefixes...) {
 				log.Printf("[INFO] Skipping Elasticache Replication Group: %s", id)
 				continue
 			}
+			if *flagSweepDryRun {
+				log.Printf("[INFO] Sweeper dry run, skipping deletion of Elasticache Replication Group: %s", id)
+				continue
+			}
 			log.Printf("[INFO] Deleting Elasticache Replication Group: %s", id)
 			err := deleteElasticacheReplicationGroup(id, conn)
 			if err != nil {
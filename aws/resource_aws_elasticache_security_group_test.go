@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
+	addTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
 		Name: "aws_elasticache_security_group",
 		F:    testSweepElasticacheCacheSecurityGroups,
 		Dependencies: []string{
@@ -47,14 +46,7 @@ func testSweepElasticacheCacheSecurityGroups(region string) error {
 
 		for _, securityGroup := range page.CacheSecurityGroups {
 			name := aws.StringValue(securityGroup.CacheSecurityGroupName)
-			skip := true
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(name, prefix) {
-					skip = false
-					break
-				}
-			}
-			if skip {
+			if testSweepSkipResourceName(name, prefixes...) {
 				log.Printf("[INFO] Skipping Elasticache Cache Security Group: %s", name)
 				continue
 			}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticsearch_domain", &resource.Sweeper{
+	addTestSweepers("aws_elasticsearch_domain", &resource.Sweeper{
 		Name: "aws_elasticsearch_domain",
 		F:    testSweepElasticSearchDomains,
 	})
@@ -43,14 +42,7 @@ func testSweepElasticSearchDomains(region string) error {
 		return fmt.Errorf("Error retrieving Elasticsearch Domains: %s", err)
 	}
 	for _, domain := range out.DomainNames {
-		skip := true
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(*domain.DomainName, prefix) {
-				skip = false
-				break
-			}
-		}
-		if skip {
+		if testSweepSkipResourceName(*domain.DomainName, prefixes...) {
 			log.Printf("[INFO] Skipping Elasticsearch Domain: %s", *domain.DomainName)
 			continue
 		}
@@ -63,6 +55,10 @@ func testSweepElasticSearchDomains(region string) error {
 			log.Printf("[ERROR] Failed to delete Elasticsearch Domain %s: %s", *domain.DomainName, err)
 			continue
 		}
+		if *flagSweepDryRun {
+			continue
+		}
+
 		err = resourceAwsElasticSearchDomainDeleteWaiter(*domain.DomainName, conn)
 		if err != nil {
 			log.Printf("[ERROR] Failed to wait for deletion of Elasticsearch Domain %s: %s", *domain.DomainName, err)
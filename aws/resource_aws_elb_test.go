@@ -7,7 +7,6 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
-	"strings"
 	"testing"
 	"time"
 
@@ -20,7 +19,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elb", &resource.Sweeper{
+	addTestSweepers("aws_elb", &resource.Sweeper{
 		Name: "aws_elb",
 		F:    testSweepELBs,
 	})
@@ -44,14 +43,7 @@ func testSweepELBs(region string) error {
 		}
 
 		for _, lb := range out.LoadBalancerDescriptions {
-			skip := true
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(*lb.LoadBalancerName, prefix) {
-					skip = false
-					break
-				}
-			}
-			if skip {
+			if testSweepSkipResourceName(*lb.LoadBalancerName, prefixes...) {
 				log.Printf("[INFO] Skipping ELB: %s", *lb.LoadBalancerName)
 				continue
 			}
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,7 +15,7 @@ import (
 const testAccGameliftBuildPrefix = "tf_acc_build_"
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	addTestSweepers("aws_gamelift_build", &resource.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    testSweepGameliftBuilds,
 	})
@@ -46,7 +45,7 @@ func testSweepGameliftBuilds(region string) error {
 	log.Printf("[INFO] Found %d Gamelift Builds", len(resp.Builds))
 
 	for _, build := range resp.Builds {
-		if !strings.HasPrefix(*build.Name, testAccGameliftBuildPrefix) {
+		if testSweepSkipResourceName(*build.Name, testAccGameliftBuildPrefix) {
 			continue
 		}
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 	"time"
 
@@ -18,7 +17,7 @@ import (
 const testAccGameliftFleetPrefix = "tf_acc_fleet_"
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	addTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -50,7 +49,7 @@ func testSweepGameliftFleets(region string) error {
 		log.Printf("[INFO] Found %d Gamelift Fleets", len(out.FleetAttributes))
 
 		for _, attr := range out.FleetAttributes {
-			if !strings.HasPrefix(*attr.Name, testAccGameliftFleetPrefix) {
+			if testSweepSkipResourceName(*attr.Name, testAccGameliftFleetPrefix) {
 				continue
 			}
 
@@ -73,6 +72,10 @@ func testSweepGameliftFleets(region string) error {
 					*attr.FleetId, err)
 			}
 
+			if *flagSweepDryRun {
+				continue
+			}
+
 			err = waitForGameliftFleetToBeDeleted(conn, *attr.FleetId, 5*time.Minute)
 			if err != nil {
 				return fmt.Errorf("Error waiting for Gamelift Fleet (%s) to be deleted: %s",
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
+	addTestSweepers("aws_glue_classifier", &resource.Sweeper{
 		Name: "aws_glue_classifier",
 		F:    testSweepGlueClassifiers,
 	})
@@ -38,8 +37,6 @@ func testSweepGlueClassifiers(region string) error {
 			return false
 		}
 		for _, classifier := range page.Classifiers {
-			skip := true
-
 			var name string
 			if classifier.GrokClassifier != nil {
 				name = aws.StringValue(classifier.GrokClassifier.Name)
@@ -53,13 +50,7 @@ func testSweepGlueClassifiers(region string) error {
 				continue
 			}
 
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(name, prefix) {
-					skip = false
-					break
-				}
-			}
-			if skip {
+			if testSweepSkipResourceName(name, prefixes...) {
 				log.Printf("[INFO] Skipping Glue Classifier: %s", name)
 				continue
 			}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
+	addTestSweepers("aws_glue_crawler", &resource.Sweeper{
 		Name: "aws_glue_crawler",
 		F:    testSweepGlueCrawlers,
 	})
@@ -38,7 +37,7 @@ func testSweepGlueCrawlers(region string) error {
 		}
 		for _, crawler := range page.Crawlers {
 			name := aws.StringValue(crawler.Name)
-			if !strings.HasPrefix(name, "tf-acc-test-") {
+			if testSweepSkipResourceName(name) {
 				log.Printf("[INFO] Skipping Glue Crawler: %s", name)
 				continue
 			}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_job", &resource.Sweeper{
+	addTestSweepers("aws_glue_job", &resource.Sweeper{
 		Name: "aws_glue_job",
 		F:    testSweepGlueJobs,
 	})
@@ -39,15 +38,8 @@ func testSweepGlueJobs(region string) error {
 			return false
 		}
 		for _, job := range page.Jobs {
-			skip := true
 			name := job.Name
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(*name, prefix) {
-					skip = false
-					break
-				}
-			}
-			if skip {
+			if testSweepSkipResourceName(*name, prefixes...) {
 				log.Printf("[INFO] Skipping Glue Job: %s", *name)
 				continue
 			}
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
+	addTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
 		Name: "aws_glue_security_configuration",
 		F:    testSweepGlueSecurityConfigurations,
 	})
@@ -44,7 +43,7 @@ func testSweepGlueSecurityConfigurations(region string) error {
 		for _, securityConfiguration := range output.SecurityConfigurations {
 			name := aws.StringValue(securityConfiguration.Name)
 
-			if !strings.HasPrefix(name, "tf-acc-test") {
+			if testSweepSkipResourceName(name, "tf-acc-test") {
 				log.Printf("[INFO] Skipping Glue Security Configuration: %s", name)
 				continue
 			}
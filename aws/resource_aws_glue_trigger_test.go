@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
+	addTestSweepers("aws_glue_trigger", &resource.Sweeper{
 		Name: "aws_glue_trigger",
 		F:    testSweepGlueTriggers,
 	})
@@ -38,15 +37,8 @@ func testSweepGlueTriggers(region string) error {
 			return false
 		}
 		for _, trigger := range page.Triggers {
-			skip := true
 			name := aws.StringValue(trigger.Name)
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(name, prefix) {
-					skip = false
-					break
-				}
-			}
-			if skip {
+			if testSweepSkipResourceName(name, prefixes...) {
 				log.Printf("[INFO] Skipping Glue Trigger: %s", name)
 				continue
 			}
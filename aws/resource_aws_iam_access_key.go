@@ -19,6 +19,9 @@ func resourceAwsIamAccessKey() *schema.Resource {
 		Create: resourceAwsIamAccessKeyCreate,
 		Read:   resourceAwsIamAccessKeyRead,
 		Delete: resourceAwsIamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamAccessKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": {
@@ -147,6 +150,25 @@ func resourceAwsIamAccessKeyReadResult(d *schema.ResourceData, key *iam.AccessKe
 	return nil
 }
 
+func resourceAwsIamAccessKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamconn := meta.(*AWSClient).iamconn
+
+	// The secret can't be read back, only the user owning the key
+	resp, err := iamconn.GetAccessKeyLastUsed(&iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IAM access key (%s) user: %s", d.Id(), err)
+	}
+	if resp.UserName == nil {
+		return nil, fmt.Errorf("IAM access key (%s) user not found", d.Id())
+	}
+
+	d.Set("user", resp.UserName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
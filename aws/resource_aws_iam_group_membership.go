@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -15,6 +16,9 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 		Read:   resourceAwsIamGroupMembershipRead,
 		Update: resourceAwsIamGroupMembershipUpdate,
 		Delete: resourceAwsIamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupMembershipImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -167,3 +171,23 @@ func addUsersToGroup(conn *iam.IAM, users []*string, group string) error {
 	}
 	return nil
 }
+
+func resourceAwsIamGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Group names can't contain slashes, so the membership name is anything
+	// before the last one. Without a slash, the name is the group name.
+	name := d.Id()
+	group := d.Id()
+	if i := strings.LastIndex(d.Id(), "/"); i != -1 {
+		name = d.Id()[:i]
+		group = d.Id()[i+1:]
+	}
+	if name == "" || group == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <group-name> or <name>/<group-name>", d.Id())
+	}
+
+	d.Set("name", name)
+	d.Set("group", group)
+	d.SetId(name)
+
+	return []*schema.ResourceData{d}, nil
+}
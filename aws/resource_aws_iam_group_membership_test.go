@@ -36,6 +36,13 @@ func TestAccAWSGroupMembership_basic(t *testing.T) {
 				),
 			},
 
+			{
+				ResourceName:      "aws_iam_group_membership.team",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", membershipName, groupName),
+				ImportStateVerify: true,
+			},
+
 			{
 				Config: testAccAWSGroupMemberConfigUpdate(groupName, userName, userName2, userName3, membershipName),
 				Check: resource.ComposeTestCheckFunc(
@@ -33,6 +33,27 @@ func TestAccAWSIAMGroupPolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSGroupPolicyAttachmentAttributes([]string{policyName}, &out),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_group_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSIAMGroupPolicyAttachmentImportStateIdFunc("aws_iam_group_policy_attachment.test-attach"),
+				// We do not have a way to align IDs since the Create function uses resource.PrefixedUniqueId()
+				// Failed state verification, resource with ID GROUP-POLICYARN not found
+				// ImportStateVerify: true,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+
+					rs := s[0]
+
+					if !strings.HasPrefix(rs.Attributes["policy_arn"], "arn:") {
+						return fmt.Errorf("expected policy_arn attribute to be set and begin with arn:, received: %s", rs.Attributes["policy_arn"])
+					}
+
+					return nil
+				},
+			},
 			{
 				Config: testAccAWSGroupPolicyAttachConfigUpdate(groupName, policyName, policyName2, policyName3),
 				Check: resource.ComposeTestCheckFunc(
@@ -95,6 +116,17 @@ func testAccCheckAWSGroupPolicyAttachmentAttributes(policies []string, out *iam.
 	}
 }
 
+func testAccAWSIAMGroupPolicyAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["group"], rs.Primary.Attributes["policy_arn"]), nil
+	}
+}
+
 func testAccAWSGroupPolicyAttachConfig(groupName, policyName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_group" "group" {
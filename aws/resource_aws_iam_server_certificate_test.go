@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	addTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    testSweepIamServerCertificates,
 	})
@@ -35,13 +35,7 @@ func testSweepIamServerCertificates(region string) error {
 
 	err = conn.ListServerCertificatesPages(&iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
 		for _, sc := range out.ServerCertificateMetadataList {
-			hasPrefix := false
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(*sc.ServerCertificateName, prefix) {
-					hasPrefix = true
-				}
-			}
-			if !hasPrefix {
+			if testSweepSkipResourceName(*sc.ServerCertificateName, prefixes...) {
 				continue
 			}
 			log.Printf("[INFO] Deleting IAM Server Certificate: %s", *sc.ServerCertificateName)
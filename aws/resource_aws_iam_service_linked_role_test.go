@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
+	addTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
 		Name: "aws_iam_service_linked_role",
 		F:    testSweepIamServiceLinkedRoles,
 	})
@@ -50,7 +50,7 @@ func testSweepIamServiceLinkedRoles(region string) error {
 				log.Printf("[ERROR] Failed to delete IAM Service Role %s: %s", roleName, err)
 				continue
 			}
-			if deletionTaskID == "" {
+			if deletionTaskID == "" || *flagSweepDryRun {
 				continue
 			}
 
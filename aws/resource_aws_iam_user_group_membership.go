@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/iam"
 
@@ -16,6 +17,9 @@ func resourceAwsIamUserGroupMembership() *schema.Resource {
 		Read:   resourceAwsIamUserGroupMembershipRead,
 		Update: resourceAwsIamUserGroupMembershipUpdate,
 		Delete: resourceAwsIamUserGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserGroupMembershipImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": {
@@ -165,3 +169,24 @@ func addUserToGroups(conn *iam.IAM, user string, groups []*string) error {
 
 	return nil
 }
+
+func resourceAwsIamUserGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) < 2 {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <user-name>/<group-name1>/...", d.Id())
+	}
+	for _, p := range idParts {
+		if p == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%q), expected <user-name>/<group-name1>/...", d.Id())
+		}
+	}
+
+	userName := idParts[0]
+	groupList := idParts[1:]
+
+	d.Set("user", userName)
+	d.Set("groups", groupList)
+	d.SetId(resource.UniqueId())
+
+	return []*schema.ResourceData{d}, nil
+}
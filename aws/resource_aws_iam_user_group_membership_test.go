@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -35,6 +36,29 @@ func TestAccAWSUserGroupMembership_basic(t *testing.T) {
 					testAccAWSUserGroupMembershipCheckGroupListForUser(userName1, []string{groupName1}, []string{groupName2, groupName3}),
 				),
 			},
+			// test importing the resource
+			{
+				ResourceName:      "aws_iam_user_group_membership.user1_test1",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSUserGroupMembershipImportStateIdFunc("aws_iam_user_group_membership.user1_test1"),
+				// The resource ID is generated with resource.UniqueId() and can't be aligned
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+
+					rs := s[0]
+
+					if rs.Attributes["user"] != userName1 {
+						return fmt.Errorf("expected user attribute to be %s, received: %s", userName1, rs.Attributes["user"])
+					}
+					if rs.Attributes["groups.#"] != "1" {
+						return fmt.Errorf("expected 1 group, received: %s", rs.Attributes["groups.#"])
+					}
+
+					return nil
+				},
+			},
 			// test adding an additional group to an existing resource
 			{
 				Config: usersAndGroupsConfig + testAccAWSUserGroupMembershipConfigAddOne,
@@ -161,6 +185,24 @@ func testAccAWSUserGroupMembershipCheckGroupListForUser(userName string, groups
 	}
 }
 
+func testAccAWSUserGroupMembershipImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		idParts := []string{rs.Primary.Attributes["user"]}
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "groups.") && k != "groups.#" {
+				idParts = append(idParts, v)
+			}
+		}
+
+		return strings.Join(idParts, "/"), nil
+	}
+}
+
 // users and groups for all other tests
 func testAccAWSUserGroupMembershipConfigUsersAndGroups(userName1, userName2, groupName1, groupName2, groupName3 string) string {
 	return fmt.Sprintf(`
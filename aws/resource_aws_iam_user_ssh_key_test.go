@@ -29,6 +29,14 @@ func TestAccAWSUserSSHKey_basic(t *testing.T) {
 					testAccCheckAWSUserSSHKeyExists("aws_iam_user_ssh_key.user", "Inactive", &conf),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_user_ssh_key.user",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSUserSSHKeyImportStateIdFunc("aws_iam_user_ssh_key.user"),
+				ImportStateVerify: true,
+				// The key body is returned reformatted by the API
+				ImportStateVerifyIgnore: []string{"public_key"},
+			},
 		},
 	})
 }
@@ -122,6 +130,17 @@ func testAccCheckAWSUserSSHKeyExists(n, status string, res *iam.GetSSHPublicKeyO
 	}
 }
 
+func testAccAWSUserSSHKeyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["username"], rs.Primary.ID, rs.Primary.Attributes["encoding"]), nil
+	}
+}
+
 const testAccAWSSSHKeyConfig_sshEncoding = `
 resource "aws_iam_user" "user" {
 	name = "test-user-%d"
@@ -40,6 +40,8 @@ func resourceAwsInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: resourceAwsInstanceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:     schema.TypeString,
@@ -322,7 +324,6 @@ func resourceAwsInstance() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  true,
-							ForceNew: true,
 						},
 
 						"device_name": {
@@ -342,7 +343,6 @@ func resourceAwsInstance() *schema.Resource {
 							Type:             schema.TypeInt,
 							Optional:         true,
 							Computed:         true,
-							ForceNew:         true,
 							DiffSuppressFunc: iopsDiffSuppressFunc,
 						},
 
@@ -357,14 +357,12 @@ func resourceAwsInstance() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"volume_type": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"volume_id": {
@@ -431,14 +429,12 @@ func resourceAwsInstance() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  true,
-							ForceNew: true,
 						},
 
 						"iops": {
 							Type:             schema.TypeInt,
 							Optional:         true,
 							Computed:         true,
-							ForceNew:         true,
 							DiffSuppressFunc: iopsDiffSuppressFunc,
 						},
 
@@ -446,14 +442,12 @@ func resourceAwsInstance() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"volume_type": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"volume_id": {
@@ -556,7 +550,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 		tagsSpec := make([]*ec2.TagSpecification, 0)
 
-		if v, ok := d.GetOk("tags"); ok {
+		if v, ok := d.GetOk("tags_all"); ok {
 			tags := tagsFromMap(v.(map[string]interface{}))
 
 			spec := &ec2.TagSpecification{
@@ -807,9 +801,9 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
-	d.Set("tags", tagsToMap(instance.Tags))
+	d.Set("tags", tagsToMap(instance.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
-	if err := readVolumeTags(conn, d); err != nil {
+	if err := readVolumeTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -897,12 +891,13 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(true)
 	restricted := meta.(*AWSClient).IsChinaCloud()
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if !d.IsNewResource() || restricted {
 			if err := setTags(conn, d); err != nil {
 				return err
 			} else {
 				d.SetPartial("tags")
+				d.SetPartial("tags_all")
 			}
 		}
 	}
@@ -1195,6 +1190,49 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("root_block_device") && !d.IsNewResource() {
+		o, n := d.GetChange("root_block_device")
+		if len(o.([]interface{})) == 1 && len(n.([]interface{})) == 1 {
+			instances, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
+				InstanceIds: []*string{aws.String(d.Id())},
+			})
+			if err != nil {
+				return fmt.Errorf("error describing instance (%s): %s", d.Id(), err)
+			}
+			rootDeviceName := aws.StringValue(instances.Reservations[0].Instances[0].RootDeviceName)
+
+			obd := o.([]interface{})[0].(map[string]interface{})
+			nbd := n.([]interface{})[0].(map[string]interface{})
+			if err := modifyInstanceBlockDevice(conn, d.Id(), rootDeviceName, obd, nbd, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("ebs_block_device") && !d.IsNewResource() {
+		o, n := d.GetChange("ebs_block_device")
+
+		// Additions and removals of block devices force a new resource,
+		// so only devices present in both sets can have been modified.
+		oldBlockDevices := make(map[string]map[string]interface{})
+		for _, v := range o.(*schema.Set).List() {
+			bd := v.(map[string]interface{})
+			oldBlockDevices[bd["device_name"].(string)] = bd
+		}
+
+		for _, v := range n.(*schema.Set).List() {
+			nbd := v.(map[string]interface{})
+			deviceName := nbd["device_name"].(string)
+			obd, ok := oldBlockDevices[deviceName]
+			if !ok {
+				continue
+			}
+			if err := modifyInstanceBlockDevice(conn, d.Id(), deviceName, obd, nbd, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
 	// TODO(mitchellh): wait for the attributes we modified to
 	// persist the change...
 
@@ -1250,6 +1288,162 @@ func InstanceStateRefreshFunc(conn *ec2.EC2, instanceID string, failStates []str
 	}
 }
 
+// resourceAwsInstanceCustomizeDiff forces a new instance for the root and EBS
+// block device changes that ModifyVolume cannot apply in place.
+func resourceAwsInstanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if diff.HasChange("root_block_device") {
+		o, n := diff.GetChange("root_block_device")
+		ol, nl := o.([]interface{}), n.([]interface{})
+		if len(ol) == 1 && len(nl) == 1 && ol[0] != nil && nl[0] != nil {
+			om, nm := ol[0].(map[string]interface{}), nl[0].(map[string]interface{})
+			if blockDeviceVolumeSizeDecreased(om, nm) {
+				if err := diff.ForceNew("root_block_device.0.volume_size"); err != nil {
+					return err
+				}
+			}
+			if blockDeviceVolumeTypeChangeRequiresNew(om, nm) {
+				if err := diff.ForceNew("root_block_device.0.volume_type"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if diff.HasChange("ebs_block_device") {
+		o, n := diff.GetChange("ebs_block_device")
+		old := make(map[string]map[string]interface{})
+		for _, v := range o.(*schema.Set).List() {
+			m := v.(map[string]interface{})
+			old[m["device_name"].(string)] = m
+		}
+
+		for _, v := range n.(*schema.Set).List() {
+			nm := v.(map[string]interface{})
+			om, ok := old[nm["device_name"].(string)]
+			if !ok {
+				continue
+			}
+
+			if blockDeviceVolumeSizeDecreased(om, nm) || blockDeviceVolumeTypeChangeRequiresNew(om, nm) {
+				// The set hash only covers device_name and snapshot_id, so the
+				// set is not re-diffed when other attributes are forced new.
+				// Marking it computed makes the forced replacement stick.
+				if err := diff.ForceNew("ebs_block_device"); err != nil {
+					return err
+				}
+				return diff.SetNewComputed("ebs_block_device")
+			}
+		}
+	}
+
+	return nil
+}
+
+// blockDeviceVolumeSizeDecreased reports whether the block device volume is
+// shrunk, which EBS volumes do not support.
+func blockDeviceVolumeSizeDecreased(o, n map[string]interface{}) bool {
+	oldSize, _ := o["volume_size"].(int)
+	newSize, _ := n["volume_size"].(int)
+	return newSize != 0 && newSize < oldSize
+}
+
+// blockDeviceVolumeTypeChangeRequiresNew reports whether the block device
+// volume type changes to or from magnetic (standard), which ModifyVolume does
+// not support.
+func blockDeviceVolumeTypeChangeRequiresNew(o, n map[string]interface{}) bool {
+	ot, _ := o["volume_type"].(string)
+	nt, _ := n["volume_type"].(string)
+	if ot == "" || nt == "" || ot == nt {
+		return false
+	}
+	return ot == ec2.VolumeTypeStandard || nt == ec2.VolumeTypeStandard
+}
+
+// modifyInstanceBlockDevice applies the differences between the old and new
+// configuration of an attached EBS block device in place. The volume size,
+// type and IOPS are changed via ModifyVolume and DeleteOnTermination via
+// ModifyInstanceAttribute.
+func modifyInstanceBlockDevice(conn *ec2.EC2, instanceID, deviceName string, o, n map[string]interface{}, timeout time.Duration) error {
+	volumeID := o["volume_id"].(string)
+	if volumeID == "" {
+		return fmt.Errorf("unable to determine volume ID of block device %s on instance (%s)", deviceName, instanceID)
+	}
+
+	input := &ec2.ModifyVolumeInput{
+		VolumeId: aws.String(volumeID),
+	}
+	modifyVolume := false
+
+	if v, ok := n["volume_size"].(int); ok && v != 0 && v != o["volume_size"].(int) {
+		modifyVolume = true
+		input.Size = aws.Int64(int64(v))
+	}
+
+	volumeTypeChanged := false
+	if v, ok := n["volume_type"].(string); ok && v != "" && v != o["volume_type"].(string) {
+		modifyVolume = true
+		volumeTypeChanged = true
+		input.VolumeType = aws.String(v)
+	}
+
+	// IOPS are only configurable for io1 volumes, see iopsDiffSuppressFunc
+	if strings.ToLower(n["volume_type"].(string)) == ec2.VolumeTypeIo1 {
+		if v, ok := n["iops"].(int); ok && v > 0 && (volumeTypeChanged || v != o["iops"].(int)) {
+			modifyVolume = true
+			input.Iops = aws.Int64(int64(v))
+		}
+	}
+
+	if modifyVolume {
+		log.Printf("[DEBUG] Modifying volume %s of instance (%s): %s", volumeID, instanceID, input)
+		_, err := conn.ModifyVolume(input)
+		if err != nil {
+			return fmt.Errorf("error modifying volume %s of instance (%s): %s", volumeID, instanceID, err)
+		}
+
+		// The volume can be used at its new size once the modification
+		// has reached the optimizing state.
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{ec2.VolumeModificationStateModifying},
+			Target:     []string{ec2.VolumeModificationStateOptimizing, ec2.VolumeModificationStateCompleted},
+			Refresh:    volumeModificationStateRefreshFunc(conn, volumeID),
+			Timeout:    timeout,
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("error waiting for volume %s of instance (%s) to be modified: %s", volumeID, instanceID, err)
+		}
+	}
+
+	if v, ok := n["delete_on_termination"].(bool); ok && v != o["delete_on_termination"].(bool) {
+		log.Printf("[DEBUG] Modifying delete_on_termination of block device %s on instance (%s)", deviceName, instanceID)
+		_, err := conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(instanceID),
+			BlockDeviceMappings: []*ec2.InstanceBlockDeviceMappingSpecification{
+				{
+					DeviceName: aws.String(deviceName),
+					Ebs: &ec2.EbsInstanceBlockDeviceSpecification{
+						DeleteOnTermination: aws.Bool(v),
+						VolumeId:            aws.String(volumeID),
+					},
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying delete_on_termination of block device %s on instance (%s): %s", deviceName, instanceID, err)
+		}
+	}
+
+	return nil
+}
+
 func stringifyStateReason(sr *ec2.StateReason) string {
 	if sr.Message != nil {
 		return *sr.Message
@@ -1603,7 +1797,7 @@ func readBlockDeviceMappingsFromConfig(
 	return blockDevices, nil
 }
 
-func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData, ignoreConfig *IgnoreTagsConfig) error {
 	volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 	if err != nil {
 		return err
@@ -1631,7 +1825,7 @@ func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 		tags = append(tags, tag)
 	}
 
-	d.Set("volume_tags", tagsToMap(tags))
+	d.Set("volume_tags", tagsToMap(tags, ignoreConfig))
 
 	return nil
 }
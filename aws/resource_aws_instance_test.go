@@ -6,7 +6,6 @@ import (
 	"os"
 	"reflect"
 	"regexp"
-	"strings"
 	"testing"
 	"time"
 
@@ -22,7 +21,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	addTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    testSweepInstances,
 	})
@@ -53,11 +52,16 @@ func testSweepInstances(region string) error {
 					}
 				}
 
-				if !strings.HasPrefix(nameTag, "tf-acc-test-") {
+				if testSweepSkipResourceName(nameTag) {
 					log.Printf("[INFO] Skipping EC2 Instance: %s", id)
 					continue
 				}
 
+				if *flagSweepDryRun {
+					log.Printf("[INFO] Sweeper dry run, skipping termination of EC2 Instance: %s", id)
+					continue
+				}
+
 				log.Printf("[INFO] Terminating EC2 Instance: %s", id)
 				err := awsTerminateInstance(conn, id, 5*time.Minute)
 				if err != nil {
@@ -1257,6 +1261,158 @@ func TestAccAWSInstance_rootBlockDeviceMismatch(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_rootBlockDeviceUpdate(t *testing.T) {
+	var before, after ec2.Instance
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigRootBlockDeviceUpdate(10, "gp2", 0, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &before),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "root_block_device.0.volume_size", "10"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "root_block_device.0.volume_type", "gp2"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "root_block_device.0.delete_on_termination", "true"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigRootBlockDeviceUpdate(12, "io1", 300, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "root_block_device.0.volume_size", "12"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "root_block_device.0.volume_type", "io1"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "root_block_device.0.iops", "300"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "root_block_device.0.delete_on_termination", "false"),
+				),
+			},
+			{
+				// Restore delete_on_termination so the root volume is cleaned up
+				Config: testAccInstanceConfigRootBlockDeviceUpdate(12, "io1", 300, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "root_block_device.0.delete_on_termination", "true"),
+				),
+			},
+			{
+				// Volumes cannot be shrunk, so this replaces the instance
+				Config: testAccInstanceConfigRootBlockDeviceUpdate(10, "io1", 300, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "root_block_device.0.volume_size", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_ebsBlockDeviceUpdate(t *testing.T) {
+	var before, after ec2.Instance
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigEbsBlockDeviceUpdate(9, "gp2", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &before),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.2576023345.volume_size", "9"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.2576023345.volume_type", "gp2"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigEbsBlockDeviceUpdate(12, "io1", 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.2576023345.volume_size", "12"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.2576023345.volume_type", "io1"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.2576023345.iops", "300"),
+				),
+			},
+			{
+				// Volumes cannot be shrunk, so this replaces the instance
+				Config: testAccInstanceConfigEbsBlockDeviceUpdate(10, "io1", 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "ebs_block_device.2576023345.volume_size", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestBlockDeviceVolumeSizeDecreased(t *testing.T) {
+	cases := []struct {
+		Old, New int
+		Expected bool
+	}{
+		{Old: 10, New: 20, Expected: false},
+		{Old: 20, New: 20, Expected: false},
+		{Old: 20, New: 10, Expected: true},
+		{Old: 20, New: 0, Expected: false},
+	}
+
+	for _, tc := range cases {
+		o := map[string]interface{}{"volume_size": tc.Old}
+		n := map[string]interface{}{"volume_size": tc.New}
+		if got := blockDeviceVolumeSizeDecreased(o, n); got != tc.Expected {
+			t.Errorf("%d -> %d: expected %t, got %t", tc.Old, tc.New, tc.Expected, got)
+		}
+	}
+}
+
+func TestBlockDeviceVolumeTypeChangeRequiresNew(t *testing.T) {
+	cases := []struct {
+		Old, New string
+		Expected bool
+	}{
+		{Old: "gp2", New: "io1", Expected: false},
+		{Old: "io1", New: "gp2", Expected: false},
+		{Old: "gp2", New: "gp2", Expected: false},
+		{Old: "standard", New: "standard", Expected: false},
+		{Old: "standard", New: "gp2", Expected: true},
+		{Old: "gp2", New: "standard", Expected: true},
+		{Old: "standard", New: "", Expected: false},
+		{Old: "", New: "standard", Expected: false},
+	}
+
+	for _, tc := range cases {
+		o := map[string]interface{}{"volume_type": tc.Old}
+		n := map[string]interface{}{"volume_type": tc.New}
+		if got := blockDeviceVolumeTypeChangeRequiresNew(o, n); got != tc.Expected {
+			t.Errorf("%q -> %q: expected %t, got %t", tc.Old, tc.New, tc.Expected, got)
+		}
+	}
+}
+
 // This test reproduces the bug here:
 //   https://github.com/hashicorp/terraform/issues/1752
 //
@@ -2005,6 +2161,16 @@ func testAccCheckInstanceNotRecreated(t *testing.T,
 	}
 }
 
+func testAccCheckInstanceRecreated(t *testing.T,
+	before, after *ec2.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *before.InstanceId == *after.InstanceId {
+			t.Fatalf("AWS Instance (%s) not recreated", *before.InstanceId)
+		}
+		return nil
+	}
+}
+
 func testAccCheckInstanceDestroy(s *terraform.State) error {
 	return testAccCheckInstanceDestroyWithProvider(s, testAccProvider)
 }
@@ -2418,6 +2584,40 @@ resource "aws_instance" "foo" {
 }
 `
 
+func testAccInstanceConfigRootBlockDeviceUpdate(size int, volumeType string, iops int, deleteOnTermination bool) string {
+	return fmt.Sprintf(`
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-55a7ea65"
+	instance_type = "m3.medium"
+
+	root_block_device {
+		volume_size = %d
+		volume_type = "%s"
+		iops = %d
+		delete_on_termination = %t
+	}
+}
+`, size, volumeType, iops, deleteOnTermination)
+}
+
+func testAccInstanceConfigEbsBlockDeviceUpdate(size int, volumeType string, iops int) string {
+	return fmt.Sprintf(`
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-55a7ea65"
+	instance_type = "m3.medium"
+
+	ebs_block_device {
+		device_name = "/dev/sdb"
+		volume_size = %d
+		volume_type = "%s"
+		iops = %d
+	}
+}
+`, size, volumeType, iops)
+}
+
 const testAccInstanceConfigSourceDestEnable = `
 resource "aws_vpc" "foo" {
 	cidr_block = "10.1.0.0/16"
@@ -94,7 +94,7 @@ func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("vpc_id", ig.Attachments[0].VpcId)
 	}
 
-	d.Set("tags", tagsToMap(ig.Tags))
+	d.Set("tags", tagsToMap(ig.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -119,6 +119,7 @@ func resourceAwsInternetGatewayUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return nil
 }
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	addTestSweepers("aws_internet_gateway", &resource.Sweeper{
 		Name: "aws_internet_gateway",
 		F:    testSweepInternetGateways,
 	})
@@ -30,11 +30,8 @@ func testSweepInternetGateways(region string) error {
 	req := &ec2.DescribeInternetGatewaysInput{
 		Filters: []*ec2.Filter{
 			{
-				Name: aws.String("tag-value"),
-				Values: []*string{
-					aws.String("terraform-testacc-*"),
-					aws.String("tf-acc-test-*"),
-				},
+				Name:   aws.String("tag-value"),
+				Values: testSweepTagValueFilterValues("terraform-testacc-", "tf-acc-test-"),
 			},
 		},
 	}
@@ -65,6 +62,10 @@ func testSweepInternetGateways(region string) error {
 				return fmt.Errorf("error detaching Internet Gateway (%s) from VPC (%s): %s", aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId), err)
 			}
 
+			if *flagSweepDryRun {
+				continue
+			}
+
 			stateConf := &resource.StateChangeConf{
 				Pending: []string{"detaching"},
 				Target:  []string{"detached"},
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	addTestSweepers("aws_key_pair", &resource.Sweeper{
 		Name: "aws_key_pair",
 		F:    testSweepKeyPairs,
 	})
@@ -59,6 +59,7 @@ func resourceAwsKmsAlias() *schema.Resource {
 
 func resourceAwsKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kmsconn
+	retryConfig := meta.(*AWSClient).retryConfig
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -79,7 +80,7 @@ func resourceAwsKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// KMS is eventually consistent
-	_, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "NotFoundException", func() (interface{}, error) {
 		return conn.CreateAlias(req)
 	})
 	if err != nil {
@@ -91,7 +91,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, exists := d.GetOk("policy"); exists {
 		req.Policy = aws.String(v.(string))
 	}
-	if v, exists := d.GetOk("tags"); exists {
+	if v, exists := d.GetOk("tags_all"); exists {
 		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
 	}
 
@@ -120,6 +120,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kmsconn
+	retryConfig := meta.(*AWSClient).retryConfig
 
 	req := &kms.DescribeKeyInput{
 		KeyId: aws.String(d.Id()),
@@ -129,7 +130,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	var err error
 	if d.IsNewResource() {
 		var out interface{}
-		out, err = retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+		out, err = retryOnAwsCode(retryConfig, "NotFoundException", func() (interface{}, error) {
 			return conn.DescribeKey(req)
 		})
 		resp, _ = out.(*kms.DescribeKeyOutput)
@@ -155,7 +156,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("key_usage", metadata.KeyUsage)
 	d.Set("is_enabled", metadata.Enabled)
 
-	pOut, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	pOut, err := retryOnAwsCode(retryConfig, "NotFoundException", func() (interface{}, error) {
 		return conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
 			KeyId:      metadata.KeyId,
 			PolicyName: aws.String("default"),
@@ -172,7 +173,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("policy", policy)
 
-	out, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	out, err := retryOnAwsCode(retryConfig, "NotFoundException", func() (interface{}, error) {
 		return conn.GetKeyRotationStatus(&kms.GetKeyRotationStatusInput{
 			KeyId: metadata.KeyId,
 		})
@@ -183,7 +184,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	krs, _ := out.(*kms.GetKeyRotationStatusOutput)
 	d.Set("enable_key_rotation", krs.KeyRotationEnabled)
 
-	tOut, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	tOut, err := retryOnAwsCode(retryConfig, "NotFoundException", func() (interface{}, error) {
 		return conn.ListResourceTags(&kms.ListResourceTagsInput{
 			KeyId: metadata.KeyId,
 		})
@@ -192,13 +193,14 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Failed to get KMS key tags (key: %s): %s", d.Get("key_id").(string), err)
 	}
 	tagList := tOut.(*kms.ListResourceTagsOutput)
-	d.Set("tags", tagsToMapKMS(tagList.Tags))
+	d.Set("tags", tagsToMapKMS(tagList.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
 
 func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kmsconn
+	retryConfig := meta.(*AWSClient).retryConfig
 
 	// We expect new keys to be enabled already
 	if d.HasChange("is_enabled") && d.Get("is_enabled").(bool) && !d.IsNewResource() {
@@ -209,18 +211,18 @@ func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("enable_key_rotation") {
-		if err := updateKmsKeyRotationStatus(conn, d); err != nil {
+		if err := updateKmsKeyRotationStatus(conn, retryConfig, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("description") {
-		if err := resourceAwsKmsKeyDescriptionUpdate(conn, d); err != nil {
+		if err := resourceAwsKmsKeyDescriptionUpdate(conn, retryConfig, d); err != nil {
 			return err
 		}
 	}
 	if d.HasChange("policy") {
-		if err := resourceAwsKmsKeyPolicyUpdate(conn, d); err != nil {
+		if err := resourceAwsKmsKeyPolicyUpdate(conn, retryConfig, d); err != nil {
 			return err
 		}
 	}
@@ -240,7 +242,7 @@ func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceAwsKmsKeyRead(d, meta)
 }
 
-func resourceAwsKmsKeyDescriptionUpdate(conn *kms.KMS, d *schema.ResourceData) error {
+func resourceAwsKmsKeyDescriptionUpdate(conn *kms.KMS, retryConfig *RetryConfig, d *schema.ResourceData) error {
 	description := d.Get("description").(string)
 	keyId := d.Get("key_id").(string)
 
@@ -250,13 +252,13 @@ func resourceAwsKmsKeyDescriptionUpdate(conn *kms.KMS, d *schema.ResourceData) e
 		Description: aws.String(description),
 		KeyId:       aws.String(keyId),
 	}
-	_, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "NotFoundException", func() (interface{}, error) {
 		return conn.UpdateKeyDescription(req)
 	})
 	return err
 }
 
-func resourceAwsKmsKeyPolicyUpdate(conn *kms.KMS, d *schema.ResourceData) error {
+func resourceAwsKmsKeyPolicyUpdate(conn *kms.KMS, retryConfig *RetryConfig, d *schema.ResourceData) error {
 	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
 	if err != nil {
 		return fmt.Errorf("policy contains an invalid JSON: %s", err)
@@ -270,7 +272,7 @@ func resourceAwsKmsKeyPolicyUpdate(conn *kms.KMS, d *schema.ResourceData) error
 		Policy:     aws.String(policy),
 		PolicyName: aws.String("default"),
 	}
-	_, err = retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	_, err = retryOnAwsCode(retryConfig, "NotFoundException", func() (interface{}, error) {
 		return conn.PutKeyPolicy(req)
 	})
 	return err
@@ -331,7 +333,7 @@ func updateKmsKeyStatus(conn *kms.KMS, id string, shouldBeEnabled bool) error {
 	return nil
 }
 
-func updateKmsKeyRotationStatus(conn *kms.KMS, d *schema.ResourceData) error {
+func updateKmsKeyRotationStatus(conn *kms.KMS, retryConfig *RetryConfig, d *schema.ResourceData) error {
 	shouldEnableRotation := d.Get("enable_key_rotation").(bool)
 
 	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
@@ -379,7 +381,7 @@ func updateKmsKeyRotationStatus(conn *kms.KMS, d *schema.ResourceData) error {
 			log.Printf("[DEBUG] Checking if KMS key %s rotation status is %t",
 				d.Id(), shouldEnableRotation)
 
-			out, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+			out, err := retryOnAwsCode(retryConfig, "NotFoundException", func() (interface{}, error) {
 				return conn.GetKeyRotationStatus(&kms.GetKeyRotationStatusInput{
 					KeyId: aws.String(d.Id()),
 				})
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kms_key", &resource.Sweeper{
+	addTestSweepers("aws_kms_key", &resource.Sweeper{
 		Name: "aws_kms_key",
 		F:    testSweepKmsKeys,
 	})
@@ -53,7 +52,7 @@ func testSweepKmsKeys(region string) error {
 				log.Printf("Error: Failed to get tags for key %q: %s", *k.KeyId, err)
 				return false
 			}
-			if !kmsTagHasPrefix(tOut.Tags, "Name", "tf-acc-test-kms-key-") {
+			if testSweepSkipResourceName(kmsTagValue(tOut.Tags, "Name"), "tf-acc-test-kms-key-") {
 				// Skip keys which don't have designated tag
 				continue
 			}
@@ -80,13 +79,13 @@ func testSweepKmsKeys(region string) error {
 	return nil
 }
 
-func kmsTagHasPrefix(tags []*kms.Tag, key, prefix string) bool {
+func kmsTagValue(tags []*kms.Tag, key string) string {
 	for _, t := range tags {
-		if *t.TagKey == key && strings.HasPrefix(*t.TagValue, prefix) {
-			return true
+		if aws.StringValue(t.TagKey) == key {
+			return aws.StringValue(t.TagValue)
 		}
 	}
-	return false
+	return ""
 }
 
 func TestAccAWSKmsKey_importBasic(t *testing.T) {
@@ -316,8 +315,9 @@ func testAccCheckAWSKmsKeyExists(name string, key *kms.KeyMetadata) resource.Tes
 		}
 
 		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		retryConfig := testAccProvider.Meta().(*AWSClient).retryConfig
 
-		o, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+		o, err := retryOnAwsCode(retryConfig, "NotFoundException", func() (interface{}, error) {
 			return conn.DescribeKey(&kms.DescribeKeyInput{
 				KeyId: aws.String(rs.Primary.ID),
 			})
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_launch_configuration", &resource.Sweeper{
+	addTestSweepers("aws_launch_configuration", &resource.Sweeper{
 		Name:         "aws_launch_configuration",
 		Dependencies: []string{"aws_autoscaling_group"},
 		F:            testSweepLaunchConfigurations,
@@ -52,14 +52,7 @@ func testSweepLaunchConfigurations(region string) error {
 	}
 	for _, lc := range resp.LaunchConfigurations {
 		name := *lc.LaunchConfigurationName
-		skip := true
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(name, prefix) {
-				skip = false
-			}
-		}
-
-		if skip {
+		if testSweepSkipResourceName(name, prefixes...) {
 			log.Printf("[INFO] Skipping Launch Configuration: %s", name)
 			continue
 		}
@@ -565,7 +565,7 @@ func flattenAwsLbTargetGroupResource(d *schema.ResourceData, meta interface{}, t
 	}
 	for _, t := range tagsResp.TagDescriptions {
 		if aws.StringValue(t.ResourceArn) == d.Id() {
-			if err := d.Set("tags", tagsToMapELBv2(t.Tags)); err != nil {
+			if err := d.Set("tags", tagsToMapELBv2(t.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 				return fmt.Errorf("error setting tags: %s", err)
 			}
 		}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
+	addTestSweepers("aws_lb_target_group", &resource.Sweeper{
 		Name: "aws_lb_target_group",
 		F:    testSweepLBTargetGroups,
 		Dependencies: []string{
@@ -47,14 +46,7 @@ func testSweepLBTargetGroups(region string) error {
 
 		for _, targetGroup := range page.TargetGroups {
 			name := aws.StringValue(targetGroup.TargetGroupName)
-			skip := true
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(name, prefix) {
-					skip = false
-					break
-				}
-			}
-			if skip {
+			if testSweepSkipResourceName(name, prefixes...) {
 				log.Printf("[INFO] Skipping LB Target Group: %s", name)
 				continue
 			}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	addTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    testSweepLightsailStaticIps,
 	})
@@ -49,7 +48,7 @@ func testSweepLightsailStaticIps(region string) error {
 		for _, staticIp := range output.StaticIps {
 			name := aws.StringValue(staticIp.Name)
 
-			if !strings.HasPrefix(name, "tf-test-") {
+			if testSweepSkipResourceName(name, "tf-test-") {
 				continue
 			}
 
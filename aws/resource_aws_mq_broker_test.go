@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
+	addTestSweepers("aws_mq_broker", &resource.Sweeper{
 		Name: "aws_mq_broker",
 		F:    testSweepMqBrokers,
 	})
@@ -95,7 +95,7 @@ func testSweepMqBrokers(region string) error {
 	log.Printf("[DEBUG] %d MQ brokers found", len(resp.BrokerSummaries))
 
 	for _, bs := range resp.BrokerSummaries {
-		if !strings.HasPrefix(*bs.BrokerName, "tf-acc-test-") {
+		if testSweepSkipResourceName(aws.StringValue(bs.BrokerName)) {
 			continue
 		}
 
@@ -106,6 +106,10 @@ func testSweepMqBrokers(region string) error {
 		if err != nil {
 			return err
 		}
+		if *flagSweepDryRun {
+			continue
+		}
+
 		err = waitForMqBrokerDeletion(conn, *bs.BrokerId)
 		if err != nil {
 			return err
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	addTestSweepers("aws_nat_gateway", &resource.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    testSweepNatGateways,
 	})
@@ -30,11 +30,8 @@ func testSweepNatGateways(region string) error {
 	req := &ec2.DescribeNatGatewaysInput{
 		Filter: []*ec2.Filter{
 			{
-				Name: aws.String("tag-value"),
-				Values: []*string{
-					aws.String("terraform-testacc-*"),
-					aws.String("tf-acc-test-*"),
-				},
+				Name:   aws.String("tag-value"),
+				Values: testSweepTagValueFilterValues("terraform-testacc-", "tf-acc-test-"),
 			},
 		},
 	}
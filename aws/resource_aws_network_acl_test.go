@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	addTestSweepers("aws_network_acl", &resource.Sweeper{
 		Name: "aws_network_acl",
 		F:    testSweepNetworkAcls,
 	})
@@ -30,10 +30,8 @@ func testSweepNetworkAcls(region string) error {
 	req := &ec2.DescribeNetworkAclsInput{
 		Filters: []*ec2.Filter{
 			{
-				Name: aws.String("tag-value"),
-				Values: []*string{
-					aws.String("tf-acc-*"),
-				},
+				Name:   aws.String("tag-value"),
+				Values: testSweepTagValueFilterValues("tf-acc-"),
 			},
 		},
 	}
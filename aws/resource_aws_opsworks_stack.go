@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -13,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 )
 
@@ -363,6 +361,17 @@ func resourceAwsOpsworksStackRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	resourceAwsOpsworksSetStackCustomCookbooksSource(d, stack.CustomCookbooksSource)
 
+	tagsResp, err := client.ListTags(&opsworks.ListTagsInput{
+		ResourceArn: stack.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for OpsWorks Stack (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(tagsResp.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
@@ -381,19 +390,9 @@ func opsworksConnForRegion(region string, meta interface{}) (*opsworks.OpsWorks,
 		return originalConn, nil
 	}
 
-	// Set up base session
-	sess, err := session.NewSession(&originalConn.Config)
-	if err != nil {
-		return nil, fmt.Errorf("Error creating AWS session: %s", err)
-	}
-
-	sess.Handlers.Build.PushBackNamed(addTerraformVersionToUserAgent)
-
-	if extraDebug := os.Getenv("TERRAFORM_AWS_AUTHFAILURE_DEBUG"); extraDebug != "" {
-		sess.Handlers.UnmarshalError.PushFrontNamed(debugAuthFailure)
-	}
-
-	newSession := sess.Copy(&aws.Config{Region: aws.String(region)})
+	// Copy the configured session rather than creating a new one so the
+	// provider handlers and HTTP client are kept.
+	newSession := meta.(*AWSClient).opsworkssess.Copy(&aws.Config{Region: aws.String(region)})
 	newOpsworksconn := opsworks.New(newSession)
 
 	log.Printf("[DEBUG] Returning new OpsWorks client")
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
+	addTestSweepers("aws_redshift_cluster", &resource.Sweeper{
 		Name: "aws_redshift_cluster",
 		F:    testSweepRedshiftClusters,
 	})
@@ -37,7 +36,7 @@ func testSweepRedshiftClusters(region string) error {
 
 		for _, c := range resp.Clusters {
 			id := *c.ClusterIdentifier
-			if !strings.HasPrefix(id, "tf-redshift-cluster-") {
+			if testSweepSkipResourceName(id, "tf-redshift-cluster-") {
 				continue
 			}
 
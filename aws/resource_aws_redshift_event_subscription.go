@@ -88,7 +88,7 @@ func resourceAwsRedshiftEventSubscriptionCreate(d *schema.ResourceData, meta int
 		SourceType:       aws.String(d.Get("source_type").(string)),
 		Severity:         aws.String(d.Get("severity").(string)),
 		EventCategories:  expandStringSet(d.Get("event_categories").(*schema.Set)),
-		Tags:             tagsFromMapRedshift(d.Get("tags").(map[string]interface{})),
+		Tags:             tagsFromMapRedshift(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Println("[DEBUG] Create Redshift Event Subscription:", request)
@@ -143,7 +143,7 @@ func resourceAwsRedshiftEventSubscriptionRead(d *schema.ResourceData, meta inter
 	if err := d.Set("customer_aws_id", sub.CustomerAwsId); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsToMapRedshift(sub.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMapRedshift(sub.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -54,7 +54,7 @@ func resourceAwsRedshiftSnapshotCopyGrantCreate(d *schema.ResourceData, meta int
 		input.KmsKeyId = aws.String(v.(string))
 	}
 
-	input.Tags = tagsFromMapRedshift(d.Get("tags").(map[string]interface{}))
+	input.Tags = tagsFromMapRedshift(d.Get("tags_all").(map[string]interface{}))
 
 	log.Printf("[DEBUG]: Adding new Redshift SnapshotCopyGrant: %s", input)
 
@@ -92,7 +92,7 @@ func resourceAwsRedshiftSnapshotCopyGrantRead(d *schema.ResourceData, meta inter
 
 	d.Set("kms_key_id", grant.KmsKeyId)
 	d.Set("snapshot_copy_grant_name", grant.SnapshotCopyGrantName)
-	if err := d.Set("tags", tagsToMapRedshift(grant.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMapRedshift(grant.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return fmt.Errorf("Error setting Redshift Snapshot Copy Grant Tags: %#v", err)
 	}
 
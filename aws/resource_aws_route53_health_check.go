@@ -361,7 +361,7 @@ func resourceAwsRoute53HealthCheckRead(d *schema.ResourceData, meta interface{})
 		tags = resp.ResourceTagSet.Tags
 	}
 
-	if err := d.Set("tags", tagsToMapR53(tags)); err != nil {
+	if err := d.Set("tags", tagsToMapR53(tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
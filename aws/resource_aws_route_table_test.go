@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	addTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    testSweepRouteTables,
 	})
@@ -30,11 +30,8 @@ func testSweepRouteTables(region string) error {
 	req := &ec2.DescribeRouteTablesInput{
 		Filters: []*ec2.Filter{
 			{
-				Name: aws.String("tag-value"),
-				Values: []*string{
-					aws.String("terraform-testacc-*"),
-					aws.String("tf-acc-test-*"),
-				},
+				Name:   aws.String("tag-value"),
+				Values: testSweepTagValueFilterValues("terraform-testacc-", "tf-acc-test-"),
 			},
 		},
 	}
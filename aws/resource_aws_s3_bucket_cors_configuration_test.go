@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSS3BucketCorsConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_cors_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketCorsConfigurationConfig(rInt, "PUT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.test"),
+					testAccCheckAWSS3BucketCors(resourceName, []*s3.CORSRule{
+						{
+							AllowedHeaders: []*string{aws.String("*")},
+							AllowedMethods: []*string{aws.String("PUT")},
+							AllowedOrigins: []*string{aws.String("https://www.example.com")},
+							ExposeHeaders:  []*string{aws.String("x-amz-server-side-encryption"), aws.String("ETag")},
+							MaxAgeSeconds:  aws.Int64(3000),
+						},
+					}),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketCorsConfigurationConfig(rInt, "POST"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketCors(resourceName, []*s3.CORSRule{
+						{
+							AllowedHeaders: []*string{aws.String("*")},
+							AllowedMethods: []*string{aws.String("POST")},
+							AllowedOrigins: []*string{aws.String("https://www.example.com")},
+							ExposeHeaders:  []*string{aws.String("x-amz-server-side-encryption"), aws.String("ETag")},
+							MaxAgeSeconds:  aws.Int64(3000),
+						},
+					}),
+				),
+			},
+			{
+				Config:   testAccAWSS3BucketCorsConfigurationConfig(rInt, "POST"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSS3BucketCorsConfigurationConfig(rInt, "POST"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_s3_bucket.test", "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.test", "cors_rule.0.allowed_methods.0", "POST"),
+					testAccCheckAWSS3BucketCors("aws_s3_bucket.test", []*s3.CORSRule{
+						{
+							AllowedHeaders: []*string{aws.String("*")},
+							AllowedMethods: []*string{aws.String("POST")},
+							AllowedOrigins: []*string{aws.String("https://www.example.com")},
+							ExposeHeaders:  []*string{aws.String("x-amz-server-side-encryption"), aws.String("ETag")},
+							MaxAgeSeconds:  aws.Int64(3000),
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketCorsConfigurationConfig(randInt int, method string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+
+  lifecycle {
+    ignore_changes = ["cors_rule"]
+  }
+}
+
+resource "aws_s3_bucket_cors_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+
+  cors_rule {
+    allowed_headers = ["*"]
+    allowed_methods = ["%s"]
+    allowed_origins = ["https://www.example.com"]
+    expose_headers  = ["x-amz-server-side-encryption", "ETag"]
+    max_age_seconds = 3000
+  }
+}
+`, randInt, method)
+}
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketLogging() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketLoggingPut,
+		Read:   resourceAwsS3BucketLoggingRead,
+		Update: resourceAwsS3BucketLoggingPut,
+		Delete: resourceAwsS3BucketLoggingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"target_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketLoggingPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	retryConfig := meta.(*AWSClient).retryConfig
+
+	logging := map[string]interface{}{
+		"target_bucket": d.Get("target_bucket").(string),
+		"target_prefix": d.Get("target_prefix").(string),
+	}
+	if err := resourceAwsS3BucketLoggingStatusPut(s3conn, retryConfig, d, logging); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketLoggingRead(d, meta)
+}
+
+func resourceAwsS3BucketLoggingRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	retryConfig := meta.(*AWSClient).retryConfig
+
+	resp, err := retryOnAwsCode(retryConfig, s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket Logging (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Logging (%s): %s", d.Id(), err)
+	}
+
+	loggingEnabled := resp.(*s3.GetBucketLoggingOutput).LoggingEnabled
+	if loggingEnabled == nil {
+		log.Printf("[WARN] S3 Bucket Logging (%s) not enabled, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	lc := flattenAwsS3BucketLogging(loggingEnabled)
+
+	d.Set("bucket", d.Id())
+	d.Set("target_bucket", lc["target_bucket"])
+	d.Set("target_prefix", lc["target_prefix"])
+
+	return nil
+}
+
+func resourceAwsS3BucketLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	retryConfig := meta.(*AWSClient).retryConfig
+
+	return resourceAwsS3BucketLoggingStatusPut(s3conn, retryConfig, d, nil)
+}
@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSS3BucketLogging_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_logging.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rInt, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.test"),
+					testAccCheckAWSS3BucketLogging(resourceName, "aws_s3_bucket.log_bucket", "log/"),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "log/"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rInt, "other/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLogging(resourceName, "aws_s3_bucket.log_bucket", "other/"),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "other/"),
+				),
+			},
+			{
+				Config:   testAccAWSS3BucketLoggingConfig(rInt, "other/"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rInt, "other/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_s3_bucket.test", "logging.#", "1"),
+					testAccCheckAWSS3BucketLogging("aws_s3_bucket.test", "aws_s3_bucket.log_bucket", "other/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketLoggingConfig(randInt int, prefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log_bucket" {
+  bucket = "tf-test-log-bucket-%d"
+  acl    = "log-delivery-write"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+
+  lifecycle {
+    ignore_changes = ["logging"]
+  }
+}
+
+resource "aws_s3_bucket_logging" "test" {
+  bucket        = "${aws_s3_bucket.test.id}"
+  target_bucket = "${aws_s3_bucket.log_bucket.id}"
+  target_prefix = "%s"
+}
+`, randInt, randInt, prefix)
+}
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccAWSS3BucketReplicationConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	region := testAccGetRegion()
+	partition := testAccGetPartition()
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+	rules := []*s3.ReplicationRule{
+		{
+			ID: aws.String("foobar"),
+			Destination: &s3.Destination{
+				Bucket:       aws.String(fmt.Sprintf("arn:%s:s3:::tf-test-bucket-destination-%d", partition, rInt)),
+				StorageClass: aws.String(s3.ObjectStorageClassStandard),
+			},
+			Prefix: aws.String("foo"),
+			Status: aws.String(s3.ReplicationRuleStatusEnabled),
+		},
+	}
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionsPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.bucket", testAccAwsRegionProviderFunc(region, &providers)),
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.destination", testAccAwsRegionProviderFunc("eu-west-1", &providers)),
+					resource.TestMatchResourceAttr(resourceName, "role", regexp.MustCompile(fmt.Sprintf("^arn:aws[\\w-]*:iam::[\\d+]+:role/tf-iam-role-replication-%d", rInt))),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					testAccCheckAWSS3BucketReplicationRules(
+						resourceName,
+						testAccAwsRegionProviderFunc(region, &providers),
+						rules,
+					),
+				),
+			},
+			{
+				Config:   testAccAWSS3BucketReplicationConfigurationConfig(rInt),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.0.rules.#", "1"),
+					testAccCheckAWSS3BucketReplicationRules("aws_s3_bucket.bucket", testAccAwsRegionProviderFunc(region, &providers), rules),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketReplicationConfigurationConfig(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+  provider = "aws.uswest2"
+  bucket   = "tf-test-bucket-%d"
+  acl      = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  lifecycle {
+    ignore_changes = ["replication_configuration"]
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  provider = "aws.euwest"
+  bucket   = "tf-test-bucket-destination-%d"
+  region   = "eu-west-1"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  provider = "aws.uswest2"
+  bucket   = "${aws_s3_bucket.bucket.id}"
+  role     = "${aws_iam_role.role.arn}"
+
+  rules {
+    id     = "foobar"
+    prefix = "foo"
+    status = "Enabled"
+
+    destination {
+      bucket        = "${aws_s3_bucket.destination.arn}"
+      storage_class = "STANDARD"
+    }
+  }
+}
+`, randInt, randInt, randInt)
+}
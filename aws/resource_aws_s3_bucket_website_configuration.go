@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketWebsiteConfigurationPut,
+		Read:   resourceAwsS3BucketWebsiteConfigurationRead,
+		Update: resourceAwsS3BucketWebsiteConfigurationPut,
+		Delete: resourceAwsS3BucketWebsiteConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"index_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"error_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"redirect_all_requests_to": {
+				Type: schema.TypeString,
+				ConflictsWith: []string{
+					"index_document",
+					"error_document",
+					"routing_rules",
+				},
+				Optional: true,
+			},
+
+			"routing_rules": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+
+			"website_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"website_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketWebsiteConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	retryConfig := meta.(*AWSClient).retryConfig
+
+	website := map[string]interface{}{
+		"index_document":           d.Get("index_document").(string),
+		"error_document":           d.Get("error_document").(string),
+		"redirect_all_requests_to": d.Get("redirect_all_requests_to").(string),
+		"routing_rules":            d.Get("routing_rules").(string),
+	}
+	if err := resourceAwsS3BucketWebsitePut(s3conn, retryConfig, d, website); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	retryConfig := meta.(*AWSClient).retryConfig
+
+	resp, err := retryOnAwsCode(retryConfig, s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
+		log.Printf("[WARN] S3 Bucket Website Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Website Configuration (%s): %s", d.Id(), err)
+	}
+
+	w, err := flattenAwsS3BucketWebsite(resp.(*s3.GetBucketWebsiteOutput))
+	if err != nil {
+		return err
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("index_document", w["index_document"])
+	d.Set("error_document", w["error_document"])
+	d.Set("redirect_all_requests_to", w["redirect_all_requests_to"])
+	d.Set("routing_rules", w["routing_rules"])
+
+	locationResponse, err := retryOnAwsCode(retryConfig, s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetBucketLocation(&s3.GetBucketLocationInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket location: %s", err)
+	}
+
+	var region string
+	if location := locationResponse.(*s3.GetBucketLocationOutput); location.LocationConstraint != nil {
+		region = *location.LocationConstraint
+	}
+
+	websiteEndpoint := WebsiteEndpoint(d.Id(), region)
+	d.Set("website_endpoint", websiteEndpoint.Endpoint)
+	d.Set("website_domain", websiteEndpoint.Domain)
+
+	return nil
+}
+
+func resourceAwsS3BucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	retryConfig := meta.(*AWSClient).retryConfig
+
+	return resourceAwsS3BucketWebsiteDelete(s3conn, retryConfig, d)
+}
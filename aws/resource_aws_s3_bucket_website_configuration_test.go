@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSS3BucketWebsiteConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(rInt, "error.html"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.test"),
+					testAccCheckAWSS3BucketWebsite(resourceName, "index.html", "error.html", "", ""),
+					resource.TestMatchResourceAttr(resourceName, "website_endpoint", regexp.MustCompile(fmt.Sprintf("^tf-test-bucket-%d\\.s3-website", rInt))),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(rInt, "404.html"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsite(resourceName, "index.html", "404.html", "", ""),
+				),
+			},
+			{
+				Config:   testAccAWSS3BucketWebsiteConfigurationConfig(rInt, "404.html"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(rInt, "404.html"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_s3_bucket.test", "website.#", "1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.test", "website.0.error_document", "404.html"),
+					testAccCheckAWSS3BucketWebsite("aws_s3_bucket.test", "index.html", "404.html", "", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketWebsiteConfiguration_redirect(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfigRedirect(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsite(resourceName, "", "", "https", "hashicorp.com"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to", "https://hashicorp.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketWebsiteConfiguration_remove(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(rInt, "error.html"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.test"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfigBucketOnly(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsite("aws_s3_bucket.test", "", "", "", ""),
+					resource.TestCheckResourceAttr("aws_s3_bucket.test", "website.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfigBucketOnly(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfig(randInt int, errorDocument string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+
+  lifecycle {
+    ignore_changes = ["website"]
+  }
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket         = "${aws_s3_bucket.test.id}"
+  index_document = "index.html"
+  error_document = "%s"
+}
+`, randInt, errorDocument)
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfigRedirect(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+
+  lifecycle {
+    ignore_changes = ["website"]
+  }
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket                   = "${aws_s3_bucket.test.id}"
+  redirect_all_requests_to = "https://hashicorp.com"
+}
+`, randInt)
+}
@@ -21,7 +21,7 @@ import (
 
 // add sweeper to delete known test sgs
 func init() {
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	addTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		F:    testSweepSecurityGroups,
 	})
@@ -37,11 +37,8 @@ func testSweepSecurityGroups(region string) error {
 	req := &ec2.DescribeSecurityGroupsInput{
 		Filters: []*ec2.Filter{
 			{
-				Name: aws.String("tag-value"),
-				Values: []*string{
-					aws.String("tf-acc-revoke*"),
-					aws.String("tf-acc-test-*"),
-				},
+				Name:   aws.String("tag-value"),
+				Values: testSweepTagValueFilterValues("tf-acc-revoke", "tf-acc-test-"),
 			},
 		},
 	}
@@ -173,11 +173,12 @@ func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).snsconn
+	retryConfig := meta.(*AWSClient).retryConfig
 
 	for terraformAttrName, snsAttrName := range SNSAttributeMap {
 		if d.HasChange(terraformAttrName) {
 			_, terraformAttrValue := d.GetChange(terraformAttrName)
-			err := updateAwsSnsTopicAttribute(d.Id(), snsAttrName, terraformAttrValue, conn)
+			err := updateAwsSnsTopicAttribute(d.Id(), snsAttrName, terraformAttrValue, conn, retryConfig)
 			if err != nil {
 				return err
 			}
@@ -242,7 +243,7 @@ func resourceAwsSnsTopicDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func updateAwsSnsTopicAttribute(topicArn, name string, value interface{}, conn *sns.SNS) error {
+func updateAwsSnsTopicAttribute(topicArn, name string, value interface{}, conn *sns.SNS, retryConfig *RetryConfig) error {
 	// Ignore an empty policy
 	if name == "Policy" && value == "" {
 		return nil
@@ -259,7 +260,7 @@ func updateAwsSnsTopicAttribute(topicArn, name string, value interface{}, conn *
 	// Retry the update in the event of an eventually consistent style of
 	// error, where say an IAM resource is successfully created but not
 	// actually available. See https://github.com/hashicorp/terraform/issues/3660
-	_, err := retryOnAwsCode(sns.ErrCodeInvalidParameterException, func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, sns.ErrCodeInvalidParameterException, func() (interface{}, error) {
 		return conn.SetTopicAttributes(&req)
 	})
 	if err != nil {
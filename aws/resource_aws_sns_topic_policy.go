@@ -50,7 +50,8 @@ func resourceAwsSnsTopicPolicyUpsert(d *schema.ResourceData, meta interface{}) e
 	// error, where say an IAM resource is successfully created but not
 	// actually available. See https://github.com/hashicorp/terraform/issues/3660
 	conn := meta.(*AWSClient).snsconn
-	_, err := retryOnAwsCode("InvalidParameter", func() (interface{}, error) {
+	retryConfig := meta.(*AWSClient).retryConfig
+	_, err := retryOnAwsCode(retryConfig, "InvalidParameter", func() (interface{}, error) {
 		return conn.SetTopicAttributes(&req)
 	})
 	if err != nil {
@@ -115,7 +116,8 @@ func resourceAwsSnsTopicPolicyDelete(d *schema.ResourceData, meta interface{}) e
 	// actually available. See https://github.com/hashicorp/terraform/issues/3660
 	log.Printf("[DEBUG] Resetting SNS Topic Policy to default: %s", req)
 	conn := meta.(*AWSClient).snsconn
-	_, err = retryOnAwsCode("InvalidParameter", func() (interface{}, error) {
+	retryConfig := meta.(*AWSClient).retryConfig
+	_, err = retryOnAwsCode(retryConfig, "InvalidParameter", func() (interface{}, error) {
 		return conn.SetTopicAttributes(&req)
 	})
 	return err
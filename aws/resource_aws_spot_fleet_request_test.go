@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	addTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    testSweepSpotFleetRequests,
 	})
@@ -38,6 +38,11 @@ func testSweepSpotFleetRequests(region string) error {
 		for _, config := range page.SpotFleetRequestConfigs {
 			id := aws.StringValue(config.SpotFleetRequestId)
 
+			if *flagSweepDryRun {
+				log.Printf("[INFO] Sweeper dry run, skipping deletion of Spot Fleet Request: %s", id)
+				continue
+			}
+
 			log.Printf("[INFO] Deleting Spot Fleet Request: %s", id)
 			err := deleteSpotFleetRequest(id, true, 5*time.Minute, conn)
 			if err != nil {
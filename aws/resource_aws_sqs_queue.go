@@ -329,7 +329,7 @@ func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	} else {
-		tags = tagsToMapGeneric(listTagsOutput.Tags)
+		tags = tagsToMapGeneric(listTagsOutput.Tags, meta.(*AWSClient).ignoreTagsConfig)
 	}
 	d.Set("tags", tags)
 
@@ -365,8 +365,8 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 }
 
 func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
 
 		if len(remove) > 0 {
@@ -283,7 +283,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("error listing SSM Document tags for %s: %s", d.Id(), err)
 	}
-	d.Set("tags", tagsToMapSSM(tagList.TagList))
+	d.Set("tags", tagsToMapSSM(tagList.TagList, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -291,7 +291,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsSSM(ssmconn, d, d.Id(), ssm.ResourceTypeForTaggingDocument); err != nil {
 			return fmt.Errorf("error setting SSM Document tags: %s", err)
 		}
@@ -135,7 +135,7 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 	}); err != nil {
 		return fmt.Errorf("Failed to get SSM parameter tags for %s: %s", d.Get("name"), err)
 	} else {
-		d.Set("tags", tagsToMapSSM(tagList.TagList))
+		d.Set("tags", tagsToMapSSM(tagList.TagList, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	arn := arn.ARN{
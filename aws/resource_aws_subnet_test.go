@@ -15,7 +15,7 @@ import (
 
 // add sweeper to delete known test subnets
 func init() {
-	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
+	addTestSweepers("aws_subnet", &resource.Sweeper{
 		Name: "aws_subnet",
 		F:    testSweepSubnets,
 		// When implemented, these should be moved to aws_network_interface
@@ -51,10 +51,8 @@ func testSweepSubnets(region string) error {
 	req := &ec2.DescribeSubnetsInput{
 		Filters: []*ec2.Filter{
 			{
-				Name: aws.String("tag-value"),
-				Values: []*string{
-					aws.String("tf-acc-*"),
-				},
+				Name:   aws.String("tag-value"),
+				Values: testSweepTagValueFilterValues("tf-acc-"),
 			},
 		},
 	}
@@ -201,7 +201,7 @@ func resourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", arn)
 
 	// Tags
-	d.Set("tags", tagsToMap(vpc.Tags))
+	d.Set("tags", tagsToMap(vpc.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	// Make sure those values are set, if an IPv6 block exists it'll be set in the loop
 	d.Set("assign_generated_ipv6_cidr_block", false)
@@ -457,6 +457,7 @@ func resourceAwsVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -16,7 +16,7 @@ import (
 
 // add sweeper to delete known test vpcs
 func init() {
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	addTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_internet_gateway",
@@ -41,11 +41,8 @@ func testSweepVPCs(region string) error {
 	req := &ec2.DescribeVpcsInput{
 		Filters: []*ec2.Filter{
 			{
-				Name: aws.String("tag-value"),
-				Values: []*string{
-					aws.String("terraform-testacc-*"),
-					aws.String("tf-acc-test-*"),
-				},
+				Name:   aws.String("tag-value"),
+				Values: testSweepTagValueFilterValues("terraform-testacc-", "tf-acc-test-"),
 			},
 		},
 	}
@@ -251,6 +248,34 @@ func TestAccAWSVpc_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpc_defaultTags(t *testing.T) {
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcConfigDefaultTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckTags(&vpc.Tags, "foo", "bar"),
+					testAccCheckTags(&vpc.Tags, "Owner", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Owner", "terraform"),
+				),
+			},
+			{
+				Config:   testAccVpcConfigDefaultTags,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSVpc_update(t *testing.T) {
 	var vpc ec2.Vpc
 	resourceName := "aws_vpc.test"
@@ -518,6 +543,25 @@ resource "aws_vpc" "test" {
 }
 `
 
+const testAccVpcConfigDefaultTags = `
+provider "aws" {
+	default_tags {
+		tags {
+			Owner = "terraform"
+		}
+	}
+}
+
+resource "aws_vpc" "test" {
+	cidr_block = "10.1.0.0/16"
+
+	tags {
+		foo = "bar"
+		Name = "terraform-testacc-vpc-default-tags"
+	}
+}
+`
+
 const testAccVpcConfigTagsUpdate = `
 resource "aws_vpc" "test" {
 	cidr_block = "10.1.0.0/16"
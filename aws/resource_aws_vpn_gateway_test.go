@@ -15,7 +15,7 @@ import (
 
 // add sweeper to delete known test VPN Gateways
 func init() {
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	addTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    testSweepVPNGateways,
 	})
@@ -31,11 +31,8 @@ func testSweepVPNGateways(region string) error {
 	req := &ec2.DescribeVpnGatewaysInput{
 		Filters: []*ec2.Filter{
 			{
-				Name: aws.String("tag-value"),
-				Values: []*string{
-					aws.String("terraform-testacc-*"),
-					aws.String("tf-acc-test-*"),
-				},
+				Name:   aws.String("tag-value"),
+				Values: testSweepTagValueFilterValues("terraform-testacc-", "tf-acc-test-"),
 			},
 		},
 	}
@@ -66,6 +63,10 @@ func testSweepVPNGateways(region string) error {
 				return fmt.Errorf("error detaching VPN Gateway (%s) from VPC (%s): %s", aws.StringValue(vpng.VpnGatewayId), aws.StringValue(vpcAttachment.VpcId), err)
 			}
 
+			if *flagSweepDryRun {
+				continue
+			}
+
 			stateConf := &resource.StateChangeConf{
 				Pending: []string{"attached", "detaching"},
 				Target:  []string{"detached"},
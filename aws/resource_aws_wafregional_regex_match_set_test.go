@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
+	addTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_match_set",
 		F:    testSweepWafRegionalRegexMatchSet,
 	})
@@ -44,7 +43,7 @@ func testSweepWafRegionalRegexMatchSet(region string) error {
 	}
 
 	for _, s := range resp.RegexMatchSets {
-		if !strings.HasPrefix(*s.Name, "tfacc") {
+		if testSweepSkipResourceName(*s.Name, "tfacc") {
 			continue
 		}
 
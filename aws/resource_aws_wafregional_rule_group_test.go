@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
+	addTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
 		Name: "aws_wafregional_rule_group",
 		F:    testSweepWafRegionalRuleGroups,
 	})
@@ -44,7 +43,7 @@ func testSweepWafRegionalRuleGroups(region string) error {
 	}
 
 	for _, group := range resp.RuleGroups {
-		if !strings.HasPrefix(*group.Name, "tfacc") {
+		if testSweepSkipResourceName(*group.Name, "tfacc") {
 			continue
 		}
 
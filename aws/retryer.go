@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// RetryModeLegacy keeps the exponential backoff of the AWS SDK default retryer.
+	RetryModeLegacy = "legacy"
+	// RetryModeStandard uses exponential backoff with full jitter, starting at
+	// the base delay and capped at the maximum backoff.
+	RetryModeStandard = "standard"
+
+	defaultRetryBaseDelay  = 1 * time.Second
+	defaultRetryMaxBackoff = 20 * time.Second
+)
+
+func retryModes() []string {
+	return []string{
+		RetryModeLegacy,
+		RetryModeStandard,
+	}
+}
+
+// RetryConfig is the retry policy configured in the provider retry block.
+type RetryConfig struct {
+	Mode                string
+	MaxAttempts         int
+	MaxBackoff          time.Duration
+	BaseDelay           time.Duration
+	RetryableErrorCodes []string
+}
+
+// isRetryableErrorCode returns whether the error code is one of the
+// additional retryable error codes of the policy.
+func (c *RetryConfig) isRetryableErrorCode(code string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, v := range c.RetryableErrorCodes {
+		if v == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before the retry following retryCount retries:
+// the base delay doubled on every retry, capped at the maximum backoff, with
+// full jitter in standard mode.
+func (c *RetryConfig) backoff(retryCount int) time.Duration {
+	baseDelay := c.BaseDelay
+	if baseDelay <= 0 {
+		baseDelay = defaultRetryBaseDelay
+	}
+	maxBackoff := c.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	if retryCount > 30 {
+		retryCount = 30
+	}
+	delay := baseDelay * time.Duration(1<<uint(retryCount))
+	if delay > maxBackoff || delay <= 0 {
+		delay = maxBackoff
+	}
+
+	if c.Mode == RetryModeStandard {
+		delay = time.Duration(rand.Int63n(int64(delay) + 1))
+	}
+
+	return delay
+}
+
+// retryer is a request.Retryer implementing the provider retry policy on
+// top of the AWS SDK default retryer.
+type retryer struct {
+	client.DefaultRetryer
+
+	config *RetryConfig
+}
+
+func newRetryer(maxRetries int, config *RetryConfig) *retryer {
+	if config.MaxAttempts > 0 {
+		maxRetries = config.MaxAttempts - 1
+	}
+
+	return &retryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: maxRetries},
+		config:         config,
+	}
+}
+
+// ShouldRetry returns true if the request should be retried, including
+// errors with one of the additional retryable error codes.
+func (r *retryer) ShouldRetry(req *request.Request) bool {
+	if req.Retryable != nil {
+		return *req.Retryable
+	}
+
+	if awsErr, ok := req.Error.(awserr.Error); ok && r.config.isRetryableErrorCode(awsErr.Code()) {
+		return true
+	}
+
+	return r.DefaultRetryer.ShouldRetry(req)
+}
+
+// RetryRules returns the delay before retrying the request.
+func (r *retryer) RetryRules(req *request.Request) time.Duration {
+	var delay time.Duration
+
+	switch r.config.Mode {
+	case RetryModeStandard:
+		delay = r.config.backoff(req.RetryCount)
+	default:
+		delay = r.DefaultRetryer.RetryRules(req)
+		if r.config.MaxBackoff > 0 && delay > r.config.MaxBackoff {
+			delay = r.config.MaxBackoff
+		}
+	}
+
+	return delay
+}
@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func testRetryerRequest(r request.Retryer, code string, statusCode int, retryCount int) *request.Request {
+	req := request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, r, &request.Operation{Name: "Test"}, nil, nil)
+	req.Error = awserr.New(code, "test error", nil)
+	req.HTTPResponse = &http.Response{StatusCode: statusCode}
+	req.RetryCount = retryCount
+	return req
+}
+
+func TestRetryerMaxRetries(t *testing.T) {
+	cases := []struct {
+		MaxRetries int
+		Config     *RetryConfig
+		Expected   int
+	}{
+		{
+			MaxRetries: 25,
+			Config:     &RetryConfig{},
+			Expected:   25,
+		},
+		{
+			MaxRetries: 25,
+			Config: &RetryConfig{
+				MaxAttempts: 5,
+			},
+			Expected: 4,
+		},
+	}
+
+	for i, tc := range cases {
+		if got := newRetryer(tc.MaxRetries, tc.Config).MaxRetries(); got != tc.Expected {
+			t.Fatalf("%d: expected %d max retries, got %d", i, tc.Expected, got)
+		}
+	}
+}
+
+func TestRetryerShouldRetry(t *testing.T) {
+	r := newRetryer(25, &RetryConfig{
+		RetryableErrorCodes: []string{"ConcurrentModificationException"},
+	})
+
+	cases := []struct {
+		Code       string
+		StatusCode int
+		Expected   bool
+	}{
+		{
+			Code:       "ConcurrentModificationException",
+			StatusCode: 400,
+			Expected:   true,
+		},
+		{
+			Code:       "Throttling",
+			StatusCode: 400,
+			Expected:   true,
+		},
+		{
+			Code:       "ValidationException",
+			StatusCode: 400,
+			Expected:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := r.ShouldRetry(testRetryerRequest(r, tc.Code, tc.StatusCode, 0)); got != tc.Expected {
+			t.Fatalf("%s: expected retry %t, got %t", tc.Code, tc.Expected, got)
+		}
+	}
+
+	req := testRetryerRequest(r, "ConcurrentModificationException", 400, 0)
+	req.Retryable = aws.Bool(false)
+	if r.ShouldRetry(req) {
+		t.Fatal("expected retry to be disabled when the request is not retryable")
+	}
+}
+
+func TestRetryerRetryRules(t *testing.T) {
+	cases := []struct {
+		Config     *RetryConfig
+		RetryCount int
+		Max        time.Duration
+	}{
+		{
+			Config: &RetryConfig{
+				Mode:       RetryModeLegacy,
+				MaxBackoff: 50 * time.Millisecond,
+			},
+			RetryCount: 10,
+			Max:        50 * time.Millisecond,
+		},
+		{
+			Config: &RetryConfig{
+				Mode:      RetryModeStandard,
+				BaseDelay: 10 * time.Millisecond,
+			},
+			RetryCount: 0,
+			Max:        10 * time.Millisecond,
+		},
+		{
+			Config: &RetryConfig{
+				Mode:       RetryModeStandard,
+				BaseDelay:  10 * time.Millisecond,
+				MaxBackoff: 30 * time.Millisecond,
+			},
+			RetryCount: 20,
+			Max:        30 * time.Millisecond,
+		},
+		{
+			Config: &RetryConfig{
+				Mode: RetryModeStandard,
+			},
+			RetryCount: 40,
+			Max:        defaultRetryMaxBackoff,
+		},
+	}
+
+	for i, tc := range cases {
+		r := newRetryer(25, tc.Config)
+		for j := 0; j < 10; j++ {
+			delay := r.RetryRules(testRetryerRequest(r, "Throttling", 400, tc.RetryCount))
+			if delay < 0 || delay > tc.Max {
+				t.Fatalf("%d: expected delay between 0 and %s, got %s", i, tc.Max, delay)
+			}
+		}
+	}
+}
+
+func TestRetryConfigIsRetryableErrorCode(t *testing.T) {
+	var c *RetryConfig
+	if c.isRetryableErrorCode("Throttling") {
+		t.Fatal("expected no retryable error codes without a retry policy")
+	}
+
+	c = &RetryConfig{
+		RetryableErrorCodes: []string{"Throttling"},
+	}
+	if !c.isRetryableErrorCode("Throttling") {
+		t.Fatal("expected Throttling to be retryable")
+	}
+	if c.isRetryableErrorCode("ValidationException") {
+		t.Fatal("expected ValidationException not to be retryable")
+	}
+}
+
+func TestRetryOnAwsCodesRetryConfig(t *testing.T) {
+	cases := []struct {
+		Config           *RetryConfig
+		Codes            []string
+		FailedAttempts   int
+		ExpectedAttempts int
+		ExpectError      bool
+	}{
+		{
+			Config: &RetryConfig{
+				MaxAttempts: 3,
+				BaseDelay:   time.Millisecond,
+				MaxBackoff:  time.Millisecond,
+			},
+			Codes:            []string{"NoSuchBucket"},
+			FailedAttempts:   5,
+			ExpectedAttempts: 6,
+		},
+		{
+			Config: &RetryConfig{
+				MaxAttempts: 3,
+				BaseDelay:   time.Millisecond,
+			},
+			Codes:            []string{"NoSuchBucket"},
+			FailedAttempts:   2,
+			ExpectedAttempts: 3,
+		},
+		{
+			Config: &RetryConfig{
+				Mode:      RetryModeStandard,
+				BaseDelay: time.Millisecond,
+			},
+			Codes:            []string{"ValidationException"},
+			FailedAttempts:   10,
+			ExpectedAttempts: 1,
+			ExpectError:      true,
+		},
+		{
+			Config: &RetryConfig{
+				Mode:                RetryModeStandard,
+				MaxAttempts:         2,
+				BaseDelay:           time.Millisecond,
+				MaxBackoff:          time.Millisecond,
+				RetryableErrorCodes: []string{"NoSuchBucket"},
+			},
+			Codes:            []string{"ValidationException"},
+			FailedAttempts:   4,
+			ExpectedAttempts: 5,
+		},
+	}
+
+	for i, tc := range cases {
+		attempts := 0
+		_, err := RetryOnAwsCodes(tc.Config, tc.Codes, func() (interface{}, error) {
+			attempts++
+			if attempts <= tc.FailedAttempts {
+				return nil, awserr.New("NoSuchBucket", "test error", nil)
+			}
+			return nil, nil
+		})
+
+		if tc.ExpectError && err == nil {
+			t.Fatalf("%d: expected error", i)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+		if attempts != tc.ExpectedAttempts {
+			t.Fatalf("%d: expected %d attempts, got %d", i, tc.ExpectedAttempts, attempts)
+		}
+	}
+}
+
+func TestRetryOnAwsCodesWithPolicyTimeout(t *testing.T) {
+	config := &RetryConfig{
+		MaxAttempts: 2,
+		BaseDelay:   time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	}
+
+	attempts := 0
+	_, err := retryOnAwsCodesWithPolicy(config, []string{"NoSuchBucket"}, 50*time.Millisecond, func() (interface{}, error) {
+		attempts++
+		return nil, awserr.New("NoSuchBucket", "test error", nil)
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts <= config.MaxAttempts {
+		t.Fatalf("expected more than %d attempts before the timeout, got %d", config.MaxAttempts, attempts)
+	}
+}
+
+func TestRetryConfigBackoff(t *testing.T) {
+	c := &RetryConfig{
+		Mode:       RetryModeLegacy,
+		BaseDelay:  10 * time.Millisecond,
+		MaxBackoff: 30 * time.Millisecond,
+	}
+
+	expected := []time.Duration{
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		30 * time.Millisecond,
+		30 * time.Millisecond,
+	}
+	for retryCount, e := range expected {
+		if got := c.backoff(retryCount); got != e {
+			t.Fatalf("%d: expected delay %s, got %s", retryCount, e, got)
+		}
+	}
+}
@@ -3,7 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
+	"reflect"
 	"strings"
 	"time"
 
@@ -34,9 +34,137 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
+// resourceWithDefaultTags adds the computed tags_all attribute to a resource
+// with a configurable tags map and wraps its functions so that tags_all holds
+// the resource tags merged with the provider default_tags. Resources send
+// tags_all to the API, while tags only reflects the resource configuration.
+func resourceWithDefaultTags(r *schema.Resource) {
+	tags, ok := r.Schema["tags"]
+	if !ok || tags.Type != schema.TypeMap || !tags.Optional || tags.Computed {
+		return
+	}
+	if _, ok := r.Schema["tags_all"]; ok {
+		return
+	}
+
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		ForceNew: tags.ForceNew,
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(diff *schema.ResourceDiff, meta interface{}) error {
+		if err := setTagsAllDiff(diff, meta); err != nil {
+			return err
+		}
+		if customizeDiff != nil {
+			return customizeDiff(diff, meta)
+		}
+		return nil
+	}
+
+	r.Create = schema.CreateFunc(wrapTagsAll(r.Create, false))
+	r.Read = schema.ReadFunc(wrapTagsAll(r.Read, true))
+	if r.Update != nil {
+		r.Update = schema.UpdateFunc(wrapTagsAll(r.Update, false))
+	}
+}
+
+// setTagsAllDiff plans tags_all as the provider default tags overlaid with the
+// resource tags, without the tags reserved for use by AWS. Resource tags
+// matching the provider ignore_tags are left out with a warning.
+func setTagsAllDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	client := meta.(*AWSClient)
+	tags := diff.Get("tags").(map[string]interface{})
+	client.ignoreTagsConfig.warnConfiguredTags(tags)
+
+	tagsAll := client.ignoreTagsConfig.removeIgnoredTags(mergeDefaultTags(client.defaultTags, tags))
+	if reflect.DeepEqual(diff.Get("tags_all").(map[string]interface{}), tagsAll) {
+		return nil
+	}
+
+	return diff.SetNew("tags_all", tagsAll)
+}
+
+// wrapTagsAll removes the provider default tags and ignored tags from the tags
+// attribute after f has set it from the API response. When refresh is true, the full set of
+// tags from the API is also stored in tags_all; otherwise the planned value of
+// tags_all is kept. Configured tags matching the ignored tags are never sent to
+// AWS, so their known values are kept to avoid a perpetual difference.
+func wrapTagsAll(f func(*schema.ResourceData, interface{}) error, refresh bool) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		known := d.Get("tags").(map[string]interface{})
+
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		if d.Id() == "" {
+			return nil
+		}
+
+		client := meta.(*AWSClient)
+		tagsAll := client.ignoreTagsConfig.removeIgnoredTags(d.Get("tags").(map[string]interface{}))
+		if refresh {
+			if err := d.Set("tags_all", tagsAll); err != nil {
+				return fmt.Errorf("error setting tags_all: %s", err)
+			}
+		}
+
+		tags := removeDefaultTags(tagsAll, client.defaultTags, known)
+		for k, v := range known {
+			if client.ignoreTagsConfig.ignored(k) {
+				tags[k] = v
+			}
+		}
+
+		if err := d.Set("tags", tags); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
+		}
+
+		return nil
+	}
+}
+
+// mergeDefaultTags returns the default tags overlaid with the resource tags.
+// Resource tags take precedence.
+func mergeDefaultTags(defaultTags, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// removeDefaultTags returns the tags read from the API without those that only
+// exist because of the provider default tags. A tag is kept if it is known to
+// be configured on the resource or its value differs from the default.
+func removeDefaultTags(tags, defaultTags, known map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if dv, ok := defaultTags[k]; ok && dv == v {
+			if _, ok := known[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
 func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
@@ -129,10 +257,10 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 }
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// tags field to be named "tags" and applies the merged "tags_all" value
 func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
@@ -216,7 +344,7 @@ func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnored(t) {
+		if !tagIgnored(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -225,10 +353,10 @@ func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMap(ts []*ec2.Tag) map[string]string {
+func tagsToMap(ts []*ec2.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnored(t) {
+		if !tagIgnored(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -257,10 +385,10 @@ func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
 }
 
 // tagsToMapELBv2 turns the list of tags into a map.
-func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
+func tagsToMapELBv2(ts []*elbv2.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
+		if !tagIgnoredELBv2(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -276,7 +404,7 @@ func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredELBv2(t) {
+		if !tagIgnoredELBv2(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -286,29 +414,13 @@ func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
 
 // tagIgnored compares a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnored(t *ec2.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
 
 // and for ELBv2 as well
-func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredELBv2(t *elbv2.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
 
 // tagsToMapDynamoDb turns the list of tags into a map for dynamoDB
@@ -339,7 +451,7 @@ func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
 // for dynamoDB only requires a list of tag keys, instead of the full map of keys.
 func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
 	arn := d.Get("arn").(string)
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := d.GetChange("tags_all")
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o), tagsFromMapDynamoDb(n))
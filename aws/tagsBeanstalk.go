@@ -38,7 +38,7 @@ func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredBeanstalk(t) {
+		if !tagIgnoredBeanstalk(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -47,10 +47,10 @@ func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
+func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredBeanstalk(t) {
+		if !tagIgnoredBeanstalk(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -60,8 +60,12 @@ func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag) bool {
-	filter := []string{"^aws:", "^elasticbeanstalk:", "Name"}
+func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	if tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig) {
+		return true
+	}
+
+	filter := []string{"^elasticbeanstalk:", "Name"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
 		if r, _ := regexp.MatchString(v, *t.Key); r == true {
@@ -47,7 +47,7 @@ func TestDiffBeanstalkTags(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsBeanstalk(tagsFromMapBeanstalk(tc.Old), tagsFromMapBeanstalk(tc.New))
-		cm := tagsToMapBeanstalk(c)
+		cm := tagsToMapBeanstalk(c, nil)
 		rl := []string{}
 		for _, tagName := range r {
 			rl = append(rl, *tagName)
@@ -72,7 +72,7 @@ func TestIgnoringTagsBeanstalk(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredBeanstalk(tag) {
+		if !tagIgnoredBeanstalk(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
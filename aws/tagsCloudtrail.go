@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
@@ -12,8 +11,8 @@ import (
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsCloudtrail(conn *cloudtrail.CloudTrail, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsCloudtrail(tagsFromMapCloudtrail(o), tagsFromMapCloudtrail(n))
@@ -77,7 +76,7 @@ func tagsFromMapCloudtrail(m map[string]interface{}) []*cloudtrail.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredCloudtrail(t) {
+		if !tagIgnoredCloudtrail(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -86,10 +85,10 @@ func tagsFromMapCloudtrail(m map[string]interface{}) []*cloudtrail.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapCloudtrail(ts []*cloudtrail.Tag) map[string]string {
+func tagsToMapCloudtrail(ts []*cloudtrail.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredCloudtrail(t) {
+		if !tagIgnoredCloudtrail(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -99,14 +98,6 @@ func tagsToMapCloudtrail(ts []*cloudtrail.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredCloudtrail(t *cloudtrail.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredCloudtrail(t *cloudtrail.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dax"
@@ -12,8 +11,8 @@ import (
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsDax(conn *dax.DAX, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsDax(tagsFromMapDax(o), tagsFromMapDax(n))
@@ -80,7 +79,7 @@ func tagsFromMapDax(m map[string]interface{}) []*dax.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredDax(t) {
+		if !tagIgnoredDax(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -89,10 +88,10 @@ func tagsFromMapDax(m map[string]interface{}) []*dax.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapDax(ts []*dax.Tag) map[string]string {
+func tagsToMapDax(ts []*dax.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredDax(t) {
+		if !tagIgnoredDax(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -102,14 +101,6 @@ func tagsToMapDax(ts []*dax.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredDax(t *dax.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredDax(t *dax.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
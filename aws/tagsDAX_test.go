@@ -48,8 +48,8 @@ func TestDaxTagsDiff(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsDax(tagsFromMapDax(tc.Old), tagsFromMapDax(tc.New))
-		cm := tagsToMapDax(c)
-		rm := tagsToMapDax(r)
+		cm := tagsToMapDax(c, nil)
+		rm := tagsToMapDax(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -70,7 +70,7 @@ func TestTagsDaxIgnore(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredDax(tag) {
+		if !tagIgnoredDax(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
@@ -11,7 +10,7 @@ import (
 
 // getTags is a helper to get the tags for a resource. It expects the
 // tags field to be named "tags"
-func getTagsDX(conn *directconnect.DirectConnect, d *schema.ResourceData, arn string) error {
+func getTagsDX(conn *directconnect.DirectConnect, d *schema.ResourceData, arn string, ignoreConfig *IgnoreTagsConfig) error {
 	resp, err := conn.DescribeTags(&directconnect.DescribeTagsInput{
 		ResourceArns: aws.StringSlice([]string{arn}),
 	})
@@ -24,7 +23,7 @@ func getTagsDX(conn *directconnect.DirectConnect, d *schema.ResourceData, arn st
 		tags = resp.ResourceTags[0].Tags
 	}
 
-	if err := d.Set("tags", tagsToMapDX(tags)); err != nil {
+	if err := d.Set("tags", tagsToMapDX(tags, ignoreConfig)); err != nil {
 		return err
 	}
 
@@ -34,8 +33,8 @@ func getTagsDX(conn *directconnect.DirectConnect, d *schema.ResourceData, arn st
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsDX(conn *directconnect.DirectConnect, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsDX(tagsFromMapDX(o), tagsFromMapDX(n))
@@ -102,7 +101,7 @@ func tagsFromMapDX(m map[string]interface{}) []*directconnect.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredDX(t) {
+		if !tagIgnoredDX(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -111,10 +110,10 @@ func tagsFromMapDX(m map[string]interface{}) []*directconnect.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapDX(ts []*directconnect.Tag) map[string]string {
+func tagsToMapDX(ts []*directconnect.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredDX(t) {
+		if !tagIgnoredDX(t, ignoreConfig) {
 			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 		}
 	}
@@ -124,14 +123,6 @@ func tagsToMapDX(ts []*directconnect.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredDX(t *directconnect.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredDX(t *directconnect.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
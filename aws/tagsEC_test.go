@@ -48,8 +48,8 @@ func TestDiffelasticacheTags(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsEC(tagsFromMapEC(tc.Old), tagsFromMapEC(tc.New))
-		cm := tagsToMapEC(c)
-		rm := tagsToMapEC(r)
+		cm := tagsToMapEC(c, nil)
+		rm := tagsToMapEC(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -70,7 +70,7 @@ func TestIgnoringTagsEC(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredEC(tag) {
+		if !tagIgnoredEC(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -48,8 +48,8 @@ func TestDiffEFSTags(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsEFS(tagsFromMapEFS(tc.Old), tagsFromMapEFS(tc.New))
-		cm := tagsToMapEFS(c)
-		rm := tagsToMapEFS(r)
+		cm := tagsToMapEFS(c, nil)
+		rm := tagsToMapEFS(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -70,7 +70,7 @@ func TestIgnoringTagsEFS(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredEFS(tag) {
+		if !tagIgnoredEFS(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
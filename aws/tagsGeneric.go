@@ -2,7 +2,8 @@ package aws
 
 import (
 	"log"
-	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 )
@@ -34,7 +35,7 @@ func diffTagsGeneric(oldTags, newTags map[string]interface{}) (map[string]*strin
 func tagsFromMapGeneric(m map[string]interface{}) map[string]*string {
 	result := make(map[string]*string)
 	for k, v := range m {
-		if !tagIgnoredGeneric(k) {
+		if !tagIgnoredGeneric(k, nil) {
 			result[k] = aws.String(v.(string))
 		}
 	}
@@ -43,10 +44,10 @@ func tagsFromMapGeneric(m map[string]interface{}) map[string]*string {
 }
 
 // tagsToMap turns the tags into a map.
-func tagsToMapGeneric(ts map[string]*string) map[string]string {
+func tagsToMapGeneric(ts map[string]*string, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for k, v := range ts {
-		if !tagIgnoredGeneric(k) {
+		if !tagIgnoredGeneric(k, ignoreConfig) {
 			result[k] = aws.StringValue(v)
 		}
 	}
@@ -55,15 +56,78 @@ func tagsToMapGeneric(ts map[string]*string) map[string]string {
 }
 
 // compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredGeneric(k string) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, k)
-		if r, _ := regexp.MatchString(v, k); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s, ignoring.\n", k)
+// be ignored or not. Every per-service tagIgnored function uses this matcher.
+// Tags reserved for use by AWS are always ignored, along with the keys and key
+// prefixes of ignoreConfig. Tags read from AWS are matched against the client
+// ignore_tags configuration; tags sent to AWS use a nil ignoreConfig.
+func tagIgnoredGeneric(k string, ignoreConfig *IgnoreTagsConfig) bool {
+	return systemIgnoreTagsConfig.ignored(k) || ignoreConfig.ignored(k)
+}
+
+// IgnoreTagsConfig contains the tag keys and key prefixes that are managed
+// outside Terraform and excluded from resource tags.
+type IgnoreTagsConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// systemIgnoreTagsConfig ignores the tags reserved for use by AWS.
+var systemIgnoreTagsConfig = &IgnoreTagsConfig{
+	KeyPrefixes: []string{"aws:"},
+}
+
+// ignored checks if a tag key matches one of the ignored keys or key prefixes.
+func (c *IgnoreTagsConfig) ignored(k string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, key := range c.Keys {
+		if k == key {
+			log.Printf("[DEBUG] Found ignored tag %s, ignoring.", k)
+			return true
+		}
+	}
+
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(k, prefix) {
+			log.Printf("[DEBUG] Found tag %s with ignored prefix %s, ignoring.", k, prefix)
 			return true
 		}
 	}
+
 	return false
 }
+
+// warnConfiguredTags logs a warning for each configured tag key matching the
+// ignored keys or key prefixes. Such a tag is neither sent to AWS nor read
+// back, so it is dropped from the diff rather than rejected.
+func (c *IgnoreTagsConfig) warnConfiguredTags(tags map[string]interface{}) []string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var ignored []string
+	for _, k := range keys {
+		if c.ignored(k) && !systemIgnoreTagsConfig.ignored(k) {
+			log.Printf("[WARN] Tag %q is configured but matches the provider ignore_tags, ignoring.", k)
+			ignored = append(ignored, k)
+		}
+	}
+
+	return ignored
+}
+
+// removeIgnoredTags returns the tags without those that should be ignored.
+func (c *IgnoreTagsConfig) removeIgnoredTags(tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if !c.ignored(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
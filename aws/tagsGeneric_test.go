@@ -48,8 +48,8 @@ func TestDiffGenericTags(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsGeneric(tc.Old, tc.New)
-		cm := tagsToMapGeneric(c)
-		rm := tagsToMapGeneric(r)
+		cm := tagsToMapGeneric(c, nil)
+		rm := tagsToMapGeneric(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -66,8 +66,128 @@ func TestIgnoringTagsGeneric(t *testing.T) {
 		"aws:foo:bar":                   aws.String("baz"),
 	}
 	for k, v := range ignoredTags {
-		if !tagIgnoredGeneric(k) {
+		if !tagIgnoredGeneric(k, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", k, *v)
 		}
 	}
 }
+
+func TestIgnoreTagsConfigIgnored(t *testing.T) {
+	config := &IgnoreTagsConfig{
+		Keys:        []string{"LastScanned"},
+		KeyPrefixes: []string{"kubernetes.io/cluster/"},
+	}
+
+	cases := []struct {
+		Key     string
+		Ignored bool
+	}{
+		{Key: "LastScanned", Ignored: true},
+		{Key: "LastScannedBy", Ignored: false},
+		{Key: "kubernetes.io/cluster/example", Ignored: true},
+		{Key: "kubernetes.io/role", Ignored: false},
+		{Key: "Name", Ignored: false},
+	}
+
+	for _, tc := range cases {
+		if ignored := config.ignored(tc.Key); ignored != tc.Ignored {
+			t.Fatalf("Tag %s: expected ignored %t, got %t", tc.Key, tc.Ignored, ignored)
+		}
+	}
+
+	var nilConfig *IgnoreTagsConfig
+	if nilConfig.ignored("LastScanned") {
+		t.Fatal("Tag LastScanned ignored by nil configuration")
+	}
+}
+
+func TestIgnoreTagsConfigRemoveIgnoredTags(t *testing.T) {
+	config := &IgnoreTagsConfig{
+		Keys:        []string{"LastScanned"},
+		KeyPrefixes: []string{"aws:", "kubernetes.io/cluster/"},
+	}
+
+	tags := map[string]interface{}{
+		"Name":                          "example",
+		"LastScanned":                   "2018-11-12",
+		"aws:cloudformation:stack-name": "example",
+		"kubernetes.io/cluster/example": "owned",
+	}
+	expected := map[string]interface{}{
+		"Name": "example",
+	}
+
+	if result := config.removeIgnoredTags(tags); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("bad tags: %#v", result)
+	}
+}
+
+func TestIgnoreTagsConfigWarnConfiguredTags(t *testing.T) {
+	config := &IgnoreTagsConfig{
+		Keys:        []string{"LastScanned"},
+		KeyPrefixes: []string{"aws:", "kubernetes.io/cluster/"},
+	}
+
+	cases := []struct {
+		Tags    map[string]interface{}
+		Ignored []string
+	}{
+		{
+			Tags: map[string]interface{}{
+				"Name": "example",
+			},
+		},
+		{
+			Tags: map[string]interface{}{
+				"Name":        "example",
+				"LastScanned": "2018-11-12",
+			},
+			Ignored: []string{"LastScanned"},
+		},
+		{
+			Tags: map[string]interface{}{
+				"kubernetes.io/cluster/example": "owned",
+				"LastScanned":                   "2018-11-12",
+			},
+			Ignored: []string{"LastScanned", "kubernetes.io/cluster/example"},
+		},
+		{
+			Tags: map[string]interface{}{
+				"aws:cloudformation:stack-name": "example",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		if ignored := config.warnConfiguredTags(tc.Tags); !reflect.DeepEqual(ignored, tc.Ignored) {
+			t.Fatalf("%d: expected ignored tags %#v, got %#v", i, tc.Ignored, ignored)
+		}
+	}
+}
+
+func TestIgnoringTagsGenericIgnoreConfig(t *testing.T) {
+	config := &IgnoreTagsConfig{
+		Keys: []string{"LastScanned"},
+	}
+
+	tags := map[string]*string{
+		"Name":                          aws.String("example"),
+		"LastScanned":                   aws.String("2018-11-12"),
+		"aws:cloudformation:stack-name": aws.String("example"),
+	}
+
+	expected := map[string]string{
+		"Name": "example",
+	}
+	if result := tagsToMapGeneric(tags, config); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("bad tags read with ignore configuration: %#v", result)
+	}
+
+	expected = map[string]string{
+		"Name":        "example",
+		"LastScanned": "2018-11-12",
+	}
+	if result := tagsToMapGeneric(tags, nil); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("bad tags read without ignore configuration: %#v", result)
+	}
+}
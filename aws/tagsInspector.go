@@ -1,9 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/inspector"
 )
@@ -16,7 +13,7 @@ func tagsFromMapInspector(m map[string]interface{}) []*inspector.ResourceGroupTa
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredInspector(t) {
+		if !tagIgnoredInspector(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -26,14 +23,6 @@ func tagsFromMapInspector(m map[string]interface{}) []*inspector.ResourceGroupTa
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredInspector(t *inspector.ResourceGroupTag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredInspector(t *inspector.ResourceGroupTag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
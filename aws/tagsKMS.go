@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
@@ -12,8 +11,8 @@ import (
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsKMS(conn *kms.KMS, d *schema.ResourceData, keyId string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsKMS(tagsFromMapKMS(o), tagsFromMapKMS(n))
@@ -80,7 +79,7 @@ func tagsFromMapKMS(m map[string]interface{}) []*kms.Tag {
 			TagKey:   aws.String(k),
 			TagValue: aws.String(v.(string)),
 		}
-		if !tagIgnoredKMS(t) {
+		if !tagIgnoredKMS(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -89,10 +88,10 @@ func tagsFromMapKMS(m map[string]interface{}) []*kms.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapKMS(ts []*kms.Tag) map[string]string {
+func tagsToMapKMS(ts []*kms.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredKMS(t) {
+		if !tagIgnoredKMS(t, ignoreConfig) {
 			result[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
 		}
 	}
@@ -102,14 +101,6 @@ func tagsToMapKMS(ts []*kms.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredKMS(t *kms.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.TagKey)
-		if r, _ := regexp.MatchString(v, *t.TagKey); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.TagKey, *t.TagValue)
-			return true
-		}
-	}
-	return false
+func tagIgnoredKMS(t *kms.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.TagKey), ignoreConfig)
 }
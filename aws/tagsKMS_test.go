@@ -49,8 +49,8 @@ func TestDiffKMSTags(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsKMS(tagsFromMapKMS(tc.Old), tagsFromMapKMS(tc.New))
-		cm := tagsToMapKMS(c)
-		rm := tagsToMapKMS(r)
+		cm := tagsToMapKMS(c, nil)
+		rm := tagsToMapKMS(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -72,7 +72,7 @@ func TestIgnoringTagsKMS(t *testing.T) {
 		TagValue: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredKMS(tag) {
+		if !tagIgnoredKMS(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.TagKey, *tag.TagValue)
 		}
 	}
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
@@ -13,8 +12,8 @@ import (
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsNeptune(conn *neptune.Neptune, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsNeptune(tagsFromMapNeptune(o), tagsFromMapNeptune(n))
@@ -81,7 +80,7 @@ func tagsFromMapNeptune(m map[string]interface{}) []*neptune.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredNeptune(t) {
+		if !tagIgnoredNeptune(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -90,10 +89,10 @@ func tagsFromMapNeptune(m map[string]interface{}) []*neptune.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapNeptune(ts []*neptune.Tag) map[string]string {
+func tagsToMapNeptune(ts []*neptune.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredNeptune(t) {
+		if !tagIgnoredNeptune(t, ignoreConfig) {
 			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 		}
 	}
@@ -103,19 +102,11 @@ func tagsToMapNeptune(ts []*neptune.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredNeptune(t *neptune.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, aws.StringValue(t.Key))
-		if r, _ := regexp.MatchString(v, aws.StringValue(t.Key)); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", aws.StringValue(t.Key), aws.StringValue(t.Value))
-			return true
-		}
-	}
-	return false
+func tagIgnoredNeptune(t *neptune.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
 
-func saveTagsNeptune(conn *neptune.Neptune, d *schema.ResourceData, arn string) error {
+func saveTagsNeptune(conn *neptune.Neptune, d *schema.ResourceData, arn string, ignoreConfig *IgnoreTagsConfig) error {
 	resp, err := conn.ListTagsForResource(&neptune.ListTagsForResourceInput{
 		ResourceName: aws.String(arn),
 	})
@@ -129,5 +120,5 @@ func saveTagsNeptune(conn *neptune.Neptune, d *schema.ResourceData, arn string)
 		dt = resp.TagList
 	}
 
-	return d.Set("tags", tagsToMapNeptune(dt))
+	return d.Set("tags", tagsToMapNeptune(dt, ignoreConfig))
 }
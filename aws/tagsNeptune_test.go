@@ -48,8 +48,8 @@ func TestDiffNeptuneTags(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsNeptune(tagsFromMapNeptune(tc.Old), tagsFromMapNeptune(tc.New))
-		cm := tagsToMapNeptune(c)
-		rm := tagsToMapNeptune(r)
+		cm := tagsToMapNeptune(c, nil)
+		rm := tagsToMapNeptune(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -70,7 +70,7 @@ func TestIgnoringTagsNeptune(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredNeptune(tag) {
+		if !tagIgnoredNeptune(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -13,8 +12,8 @@ import (
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsRDS(tagsFromMapRDS(o), tagsFromMapRDS(n))
@@ -81,7 +80,7 @@ func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredRDS(t) {
+		if !tagIgnoredRDS(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -90,10 +89,10 @@ func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapRDS(ts []*rds.Tag) map[string]string {
+func tagsToMapRDS(ts []*rds.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredRDS(t) {
+		if !tagIgnoredRDS(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -101,7 +100,7 @@ func tagsToMapRDS(ts []*rds.Tag) map[string]string {
 	return result
 }
 
-func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
+func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string, ignoreConfig *IgnoreTagsConfig) error {
 	resp, err := conn.ListTagsForResource(&rds.ListTagsForResourceInput{
 		ResourceName: aws.String(arn),
 	})
@@ -115,19 +114,11 @@ func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
 		dt = resp.TagList
 	}
 
-	return d.Set("tags", tagsToMapRDS(dt))
+	return d.Set("tags", tagsToMapRDS(dt, ignoreConfig))
 }
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredRDS(t *rds.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredRDS(t *rds.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
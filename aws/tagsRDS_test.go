@@ -48,8 +48,8 @@ func TestDiffRDSTags(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsRDS(tagsFromMapRDS(tc.Old), tagsFromMapRDS(tc.New))
-		cm := tagsToMapRDS(c)
-		rm := tagsToMapRDS(r)
+		cm := tagsToMapRDS(c, nil)
+		rm := tagsToMapRDS(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -70,7 +70,7 @@ func TestIgnoringTagsRDS(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredRDS(tag) {
+		if !tagIgnoredRDS(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
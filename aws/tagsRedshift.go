@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
@@ -10,8 +9,8 @@ import (
 )
 
 func setTagsRedshift(conn *redshift.Redshift, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsRedshift(tagsFromMapRedshift(o), tagsFromMapRedshift(n))
@@ -74,7 +73,7 @@ func tagsFromMapRedshift(m map[string]interface{}) []*redshift.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredRedshift(t) {
+		if !tagIgnoredRedshift(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -82,10 +81,10 @@ func tagsFromMapRedshift(m map[string]interface{}) []*redshift.Tag {
 	return result
 }
 
-func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
+func tagsToMapRedshift(ts []*redshift.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredRedshift(t) {
+		if !tagIgnoredRedshift(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -95,14 +94,6 @@ func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredRedshift(t *redshift.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredRedshift(t *redshift.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
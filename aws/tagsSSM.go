@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -12,8 +11,8 @@ import (
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsSSM(conn *ssm.SSM, d *schema.ResourceData, id, resourceType string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsSSM(tagsFromMapSSM(o), tagsFromMapSSM(n))
@@ -82,7 +81,7 @@ func tagsFromMapSSM(m map[string]interface{}) []*ssm.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredSSM(t) {
+		if !tagIgnoredSSM(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -91,10 +90,10 @@ func tagsFromMapSSM(m map[string]interface{}) []*ssm.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapSSM(ts []*ssm.Tag) map[string]string {
+func tagsToMapSSM(ts []*ssm.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredSSM(t) {
+		if !tagIgnoredSSM(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -104,14 +103,6 @@ func tagsToMapSSM(ts []*ssm.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredSSM(t *ssm.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredSSM(t *ssm.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
@@ -49,8 +49,8 @@ func TestDiffSSMTags(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsSSM(tagsFromMapSSM(tc.Old), tagsFromMapSSM(tc.New))
-		cm := tagsToMapSSM(c)
-		rm := tagsToMapSSM(r)
+		cm := tagsToMapSSM(c, nil)
+		rm := tagsToMapSSM(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -72,7 +72,7 @@ func TestIgnoringTagsSSM(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredSSM(tag) {
+		if !tagIgnoredSSM(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -1,9 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 )
@@ -39,7 +36,7 @@ func tagsFromMapSecretsManager(m map[string]interface{}) []*secretsmanager.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredSecretsManager(t) {
+		if !tagIgnoredSecretsManager(t, nil) {
 			result = append(result, t)
 		}
 	}
@@ -48,10 +45,10 @@ func tagsFromMapSecretsManager(m map[string]interface{}) []*secretsmanager.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapSecretsManager(ts []*secretsmanager.Tag) map[string]string {
+func tagsToMapSecretsManager(ts []*secretsmanager.Tag, ignoreConfig *IgnoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredSecretsManager(t) {
+		if !tagIgnoredSecretsManager(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -61,14 +58,6 @@ func tagsToMapSecretsManager(ts []*secretsmanager.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredSecretsManager(t *secretsmanager.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredSecretsManager(t *secretsmanager.Tag, ignoreConfig *IgnoreTagsConfig) bool {
+	return tagIgnoredGeneric(aws.StringValue(t.Key), ignoreConfig)
 }
@@ -49,8 +49,8 @@ func TestDiffSecretsManagerTags(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsSecretsManager(tagsFromMapSecretsManager(tc.Old), tagsFromMapSecretsManager(tc.New))
-		cm := tagsToMapSecretsManager(c)
-		rm := tagsToMapSecretsManager(r)
+		cm := tagsToMapSecretsManager(c, nil)
+		rm := tagsToMapSecretsManager(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -72,7 +72,7 @@ func TestIgnoringTagsSecretsManager(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredSecretsManager(tag) {
+		if !tagIgnoredSecretsManager(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -48,8 +48,8 @@ func TestDiffTagsKinesis(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsKinesis(tagsFromMapKinesis(tc.Old), tagsFromMapKinesis(tc.New))
-		cm := tagsToMapKinesis(c)
-		rm := tagsToMapKinesis(r)
+		cm := tagsToMapKinesis(c, nil)
+		rm := tagsToMapKinesis(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -70,7 +70,7 @@ func TestIgnoringTagsKinesis(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredKinesis(tag) {
+		if !tagIgnoredKinesis(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
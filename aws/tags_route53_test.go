@@ -51,8 +51,8 @@ func TestDiffTagsR53(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTagsR53(tagsFromMapR53(tc.Old), tagsFromMapR53(tc.New))
-		cm := tagsToMapR53(c)
-		rm := tagsToMapR53(r)
+		cm := tagsToMapR53(c, nil)
+		rm := tagsToMapR53(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -73,7 +73,7 @@ func TestIgnoringTagsRoute53(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredRoute53(tag) {
+		if !tagIgnoredRoute53(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -83,7 +83,7 @@ func TestIgnoringTagsRoute53(t *testing.T) {
 func testAccCheckTagsR53(
 	ts *[]*route53.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMapR53(*ts)
+		m := tagsToMapR53(*ts, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
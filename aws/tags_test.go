@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -83,8 +84,8 @@ func TestDiffTags(t *testing.T) {
 
 	for i, tc := range cases {
 		c, r := diffTags(tagsFromMap(tc.Old), tagsFromMap(tc.New))
-		cm := tagsToMap(c)
-		rm := tagsToMap(r)
+		cm := tagsToMap(c, nil)
+		rm := tagsToMap(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -106,12 +107,164 @@ func TestIgnoringTags(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnored(tag) {
+		if !tagIgnored(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
 }
 
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		DefaultTags, Tags map[string]interface{}
+		Expected          map[string]interface{}
+	}{
+		{
+			DefaultTags: map[string]interface{}{},
+			Tags: map[string]interface{}{
+				"foo": "bar",
+			},
+			Expected: map[string]interface{}{
+				"foo": "bar",
+			},
+		},
+		{
+			DefaultTags: map[string]interface{}{
+				"owner": "team",
+			},
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"owner": "team",
+			},
+		},
+		{
+			DefaultTags: map[string]interface{}{
+				"owner": "team",
+				"env":   "dev",
+			},
+			Tags: map[string]interface{}{
+				"env": "prod",
+				"foo": "bar",
+			},
+			Expected: map[string]interface{}{
+				"owner": "team",
+				"env":   "prod",
+				"foo":   "bar",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		m := mergeDefaultTags(tc.DefaultTags, tc.Tags)
+		if !reflect.DeepEqual(m, tc.Expected) {
+			t.Fatalf("%d: bad tags: %#v", i, m)
+		}
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	cases := []struct {
+		Tags, DefaultTags, Known map[string]interface{}
+		Expected                 map[string]interface{}
+	}{
+		// Default tag only
+		{
+			Tags: map[string]interface{}{
+				"owner": "team",
+				"foo":   "bar",
+			},
+			DefaultTags: map[string]interface{}{
+				"owner": "team",
+			},
+			Known: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"foo": "bar",
+			},
+		},
+
+		// Default tag also configured on the resource
+		{
+			Tags: map[string]interface{}{
+				"owner": "team",
+			},
+			DefaultTags: map[string]interface{}{
+				"owner": "team",
+			},
+			Known: map[string]interface{}{
+				"owner": "team",
+			},
+			Expected: map[string]interface{}{
+				"owner": "team",
+			},
+		},
+
+		// Default tag overridden on the resource
+		{
+			Tags: map[string]interface{}{
+				"owner": "other",
+			},
+			DefaultTags: map[string]interface{}{
+				"owner": "team",
+			},
+			Known: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"owner": "other",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		m := removeDefaultTags(tc.Tags, tc.DefaultTags, tc.Known)
+		if !reflect.DeepEqual(m, tc.Expected) {
+			t.Fatalf("%d: bad tags: %#v", i, m)
+		}
+	}
+}
+
+func TestWrapTagsAllKeepsConfiguredIgnoredTags(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+	d := r.TestResourceData()
+	d.SetId("test")
+	d.Set("tags", map[string]interface{}{
+		"Name":        "example",
+		"LastScanned": "2018-11-12",
+	})
+
+	client := &AWSClient{
+		ignoreTagsConfig: &IgnoreTagsConfig{
+			Keys: []string{"LastScanned"},
+		},
+	}
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		return d.Set("tags", map[string]interface{}{
+			"Name":        "example",
+			"LastScanned": "2019-01-01",
+		})
+	}
+	if err := wrapTagsAll(read, true)(d, client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedTags := map[string]interface{}{
+		"Name":        "example",
+		"LastScanned": "2018-11-12",
+	}
+	if tags := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(tags, expectedTags) {
+		t.Fatalf("bad tags: %#v", tags)
+	}
+
+	expectedTagsAll := map[string]interface{}{
+		"Name": "example",
+	}
+	if tags := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(tags, expectedTagsAll) {
+		t.Fatalf("bad tags_all: %#v", tags)
+	}
+}
+
 func TestTagsMapToHash(t *testing.T) {
 	cases := []struct {
 		Left, Right map[string]interface{}
@@ -160,7 +313,7 @@ func TestTagsMapToHash(t *testing.T) {
 func testAccCheckTags(
 	ts *[]*ec2.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMap(*ts)
+		m := tagsToMap(*ts, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
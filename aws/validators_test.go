@@ -3069,3 +3069,33 @@ func TestValidateSecretManagerSecretNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDuration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "20s",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1m30s",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-5s",
+			ErrCount: 1,
+		},
+		{
+			Value:    "twenty",
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validateDuration(tc.Value, "max_backoff")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}